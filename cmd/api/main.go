@@ -16,6 +16,7 @@ import (
 	"github.com/vijayaragavanmg/learning-go-shop/internal/database"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/events"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/interfaces"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/invoice"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/logger"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/providers"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
@@ -43,6 +44,11 @@ import (
 // @in header
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
+
+// @securityDefinitions.apikey CartToken
+// @in header
+// @name X-Cart-Token
+// @description Guest cart token returned when the guest cart is created.
 func main() {
 	log := logger.New()
 	cfg, err := config.Load()
@@ -79,26 +85,66 @@ func main() {
 	cartRepo := repositories.NewCartRepository(db)
 	productRepo := repositories.NewProductRepository(db)
 	orderRepo := repositories.NewOrderRepository(db)
+	idempotencyRepo := repositories.NewIdempotencyRepository(db)
+	addressRepo := repositories.NewAddressRepository(db)
+	paymentRepo := repositories.NewPaymentRepository(db)
+	couponRepo := repositories.NewCouponRepository(db)
+	taxRateRepo := repositories.NewTaxRateRepository(db)
+	shippingRepo := repositories.NewShippingRepository(db)
+	returnRepo := repositories.NewReturnRepository(db)
+	invoiceRepo := repositories.NewInvoiceRepository(db)
+	reservationRepo := repositories.NewStockReservationRepository(db)
+	wishlistRepo := repositories.NewWishlistRepository(db)
+
+	var paymentProvider interfaces.PaymentProvider
+	switch cfg.Payment.Provider {
+	case "fake":
+		paymentProvider = providers.NewFakePaymentProvider(cfg.Payment.WebhookSecret, log)
+	default:
+		log.Fatal().Str("provider", cfg.Payment.Provider).Msg("unsupported payment provider")
+	}
 
 	authService := services.NewAuthService(userRepo, cartRepo, cfg, eventPublisher)
 	productService := services.NewProductService(productRepo)
 	userService := services.NewUserService(userRepo)
-	cartService := services.NewCartService(cartRepo, productRepo)
-	orderService := services.NewOrderService(orderRepo)
-
-	var uploadProvider interfaces.UploadProvider
+	taxService := services.NewTaxService(taxRateRepo, cfg.Tax.PricesIncludeTax)
+	cartService := services.NewCartService(cartRepo, productRepo, couponRepo, addressRepo, orderRepo, reservationRepo, taxService, cfg)
+	shippingService := services.NewShippingService(shippingRepo, cartRepo, addressRepo)
+	paymentService := services.NewPaymentService(paymentRepo, paymentProvider)
+	orderService := services.NewOrderService(orderRepo, addressRepo, shippingService, taxService, paymentService, eventPublisher)
+	returnService := services.NewReturnService(returnRepo, paymentService, eventPublisher)
+	addressService := services.NewAddressService(addressRepo)
+	couponService := services.NewCouponService(couponRepo)
+	idempotencyService := services.NewIdempotencyService(idempotencyRepo)
+	wishlistService := services.NewWishlistService(wishlistRepo, productRepo, cartService)
+
+	// Invoices go to private storage, which unlike uploads isn't served, so
+	// they can only be downloaded through the invoice handlers
+	var uploadProvider, privateUploadProvider interfaces.UploadProvider
 	if cfg.Upload.UploadProvider == "s3" {
-		uploadProvider = providers.NewS3Provider(cfg, log)
+		uploadProvider = providers.NewS3Provider(cfg, cfg.AWS.S3Bucket, log)
+		privateUploadProvider = providers.NewS3Provider(cfg, cfg.AWS.S3PrivateBucket, log)
 	} else {
 		uploadProvider = providers.NewLocalUploadProvider(cfg.Upload.Path, log)
+		privateUploadProvider = providers.NewLocalUploadProvider(cfg.Upload.PrivatePath, log)
 	}
 	uploadService := services.NewUploadService(uploadProvider)
+	invoiceService := services.NewInvoiceService(orderRepo, invoiceRepo, privateUploadProvider, invoice.Seller{
+		Name:    cfg.Invoice.SellerName,
+		Address: cfg.Invoice.SellerAddress,
+		Email:   cfg.Invoice.SellerEmail,
+		TaxID:   cfg.Invoice.SellerTaxID,
+	})
 	srv := server.New(cfg,
 		log,
 		authService,
 		productService,
 		userService, uploadService,
-		cartService, orderService)
+		cartService, orderService,
+		idempotencyService, addressService,
+		couponService, taxService,
+		shippingService, returnService,
+		invoiceService, wishlistService)
 	router := srv.SetupRoutes()
 
 	httpServer := &http.Server{
@@ -108,6 +154,10 @@ func main() {
 		WriteTimeout: 10 * time.Second,
 	}
 
+	sweeperCtx, stopSweeper := context.WithCancel(context.Background())
+	defer stopSweeper()
+	go services.NewStockReservationSweeper(reservationRepo, cfg.Checkout.ReservationSweepInterval).Run(sweeperCtx)
+
 	go func() {
 		log.Info().Str("port", cfg.Server.Port).Msg("starting http server")
 		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -120,6 +170,7 @@ func main() {
 	<-quit
 
 	log.Info().Msg("shutting down server")
+	stopSweeper()
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
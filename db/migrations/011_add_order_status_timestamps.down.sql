@@ -0,0 +1,5 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS confirmed_at,
+    DROP COLUMN IF EXISTS shipped_at,
+    DROP COLUMN IF EXISTS delivered_at,
+    DROP COLUMN IF EXISTS cancelled_at;
@@ -0,0 +1,5 @@
+ALTER TABLE orders
+    ADD COLUMN confirmed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN shipped_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN delivered_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN cancelled_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,2 @@
+ALTER TABLE products
+    ADD CONSTRAINT chk_products_stock_non_negative CHECK (stock >= 0);
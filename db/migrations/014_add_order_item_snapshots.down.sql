@@ -0,0 +1,6 @@
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS product_name,
+    DROP COLUMN IF EXISTS product_sku,
+    DROP COLUMN IF EXISTS unit_price,
+    DROP COLUMN IF EXISTS line_total,
+    DROP COLUMN IF EXISTS image_url;
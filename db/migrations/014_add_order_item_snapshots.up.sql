@@ -0,0 +1,25 @@
+ALTER TABLE order_items
+    ADD COLUMN product_name VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN product_sku VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN unit_price DECIMAL(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN line_total DECIMAL(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN image_url VARCHAR(500);
+
+-- Backfill existing rows from the current product data. order_items.price has
+-- always held the line total, so the unit price is derived from it.
+UPDATE order_items oi
+SET product_name = p.name,
+    product_sku  = p.sku,
+    unit_price   = ROUND(oi.price / oi.quantity, 2),
+    line_total   = oi.price,
+    image_url    = (
+        SELECT pi.url
+        FROM product_images pi
+        WHERE pi.product_id = p.id
+          AND pi.is_primary = true
+          AND pi.deleted_at IS NULL
+        ORDER BY pi.id
+        LIMIT 1
+    )
+FROM products p
+WHERE p.id = oi.product_id;
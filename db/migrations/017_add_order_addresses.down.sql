@@ -0,0 +1,19 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS shipping_first_name,
+    DROP COLUMN IF EXISTS shipping_last_name,
+    DROP COLUMN IF EXISTS shipping_phone,
+    DROP COLUMN IF EXISTS shipping_line1,
+    DROP COLUMN IF EXISTS shipping_line2,
+    DROP COLUMN IF EXISTS shipping_city,
+    DROP COLUMN IF EXISTS shipping_region,
+    DROP COLUMN IF EXISTS shipping_postal_code,
+    DROP COLUMN IF EXISTS shipping_country,
+    DROP COLUMN IF EXISTS billing_first_name,
+    DROP COLUMN IF EXISTS billing_last_name,
+    DROP COLUMN IF EXISTS billing_phone,
+    DROP COLUMN IF EXISTS billing_line1,
+    DROP COLUMN IF EXISTS billing_line2,
+    DROP COLUMN IF EXISTS billing_city,
+    DROP COLUMN IF EXISTS billing_region,
+    DROP COLUMN IF EXISTS billing_postal_code,
+    DROP COLUMN IF EXISTS billing_country;
@@ -0,0 +1,14 @@
+CREATE TABLE payments (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    transaction_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'authorized', 'captured', 'refunded', 'voided', 'failed')),
+    amount DECIMAL(10,2) NOT NULL,
+    failure_reason VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(provider, transaction_id)
+);
+
+CREATE INDEX idx_payments_order_id ON payments(order_id);
@@ -0,0 +1,54 @@
+CREATE TABLE coupons (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) UNIQUE NOT NULL,
+    description TEXT,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('percentage', 'fixed_amount', 'free_shipping')),
+    percent_off INTEGER NOT NULL DEFAULT 0 CHECK (percent_off BETWEEN 0 AND 100),
+    amount_off DECIMAL(10,2) NOT NULL DEFAULT 0,
+    min_cart_value DECIMAL(10,2) NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    usage_limit INTEGER NOT NULL DEFAULT 0,
+    usage_limit_per_user INTEGER NOT NULL DEFAULT 0,
+    times_used INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_coupons_deleted_at ON coupons(deleted_at);
+
+CREATE TABLE coupon_products (
+    coupon_id INTEGER NOT NULL REFERENCES coupons(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    PRIMARY KEY (coupon_id, product_id)
+);
+
+CREATE TABLE coupon_categories (
+    coupon_id INTEGER NOT NULL REFERENCES coupons(id) ON DELETE CASCADE,
+    category_id INTEGER NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (coupon_id, category_id)
+);
+
+CREATE TABLE coupon_redemptions (
+    id SERIAL PRIMARY KEY,
+    coupon_id INTEGER NOT NULL REFERENCES coupons(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    amount DECIMAL(10,2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(order_id)
+);
+
+CREATE INDEX idx_coupon_redemptions_coupon_user ON coupon_redemptions(coupon_id, user_id);
+
+ALTER TABLE carts
+    ADD COLUMN coupon_id INTEGER REFERENCES coupons(id) ON DELETE SET NULL;
+
+ALTER TABLE orders
+    ADD COLUMN subtotal_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN discount_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN coupon_code VARCHAR(50);
+
+UPDATE orders SET subtotal_amount = total_amount;
@@ -0,0 +1,12 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS tax_amount,
+    DROP COLUMN IF EXISTS prices_include_tax;
+
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS discount_amount,
+    DROP COLUMN IF EXISTS tax_amount,
+    DROP COLUMN IF EXISTS tax_rate_basis_points;
+
+ALTER TABLE products DROP COLUMN IF EXISTS tax_class;
+
+DROP TABLE IF EXISTS tax_rates;
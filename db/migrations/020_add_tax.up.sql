@@ -0,0 +1,23 @@
+CREATE TABLE tax_rates (
+    id SERIAL PRIMARY KEY,
+    country CHAR(2) NOT NULL,
+    region VARCHAR(100) NOT NULL DEFAULT '',
+    tax_class VARCHAR(50) NOT NULL,
+    name VARCHAR(100),
+    basis_points INTEGER NOT NULL CHECK (basis_points >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(country, region, tax_class)
+);
+
+ALTER TABLE products
+    ADD COLUMN tax_class VARCHAR(50) NOT NULL DEFAULT 'standard';
+
+ALTER TABLE order_items
+    ADD COLUMN discount_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN tax_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN tax_rate_basis_points INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE orders
+    ADD COLUMN tax_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    ADD COLUMN prices_include_tax BOOLEAN NOT NULL DEFAULT false;
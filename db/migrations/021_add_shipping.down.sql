@@ -0,0 +1,10 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS shipping_method_id,
+    DROP COLUMN IF EXISTS shipping_method_name,
+    DROP COLUMN IF EXISTS shipping_amount;
+
+ALTER TABLE products DROP COLUMN IF EXISTS weight_grams;
+
+DROP TABLE IF EXISTS shipping_methods;
+DROP TABLE IF EXISTS shipping_zone_locations;
+DROP TABLE IF EXISTS shipping_zones;
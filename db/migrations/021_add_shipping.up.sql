@@ -0,0 +1,51 @@
+CREATE TABLE shipping_zones (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_shipping_zones_deleted_at ON shipping_zones(deleted_at);
+
+CREATE TABLE shipping_zone_locations (
+    id SERIAL PRIMARY KEY,
+    zone_id INTEGER NOT NULL REFERENCES shipping_zones(id) ON DELETE CASCADE,
+    country CHAR(2) NOT NULL,
+    region VARCHAR(100) NOT NULL DEFAULT '',
+    UNIQUE(zone_id, country, region)
+);
+
+CREATE INDEX idx_shipping_zone_locations_country ON shipping_zone_locations(country);
+
+CREATE TABLE shipping_methods (
+    id SERIAL PRIMARY KEY,
+    zone_id INTEGER NOT NULL REFERENCES shipping_zones(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(30) NOT NULL CHECK (type IN ('flat_rate', 'weight_based', 'free_over_threshold')),
+    rate DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (rate >= 0),
+    per_kg_rate DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (per_kg_rate >= 0),
+    free_threshold DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (free_threshold >= 0),
+    is_active BOOLEAN DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_shipping_methods_zone_id ON shipping_methods(zone_id);
+CREATE INDEX idx_shipping_methods_deleted_at ON shipping_methods(deleted_at);
+
+ALTER TABLE products
+    ADD COLUMN weight_grams INTEGER NOT NULL DEFAULT 0 CHECK (weight_grams >= 0);
+
+ALTER TABLE orders
+    ADD COLUMN shipping_method_id INTEGER REFERENCES shipping_methods(id) ON DELETE SET NULL,
+    ADD COLUMN shipping_method_name VARCHAR(100),
+    ADD COLUMN shipping_amount DECIMAL(10,2) NOT NULL DEFAULT 0;
+
+-- Keep checkout working until zones are configured: a catch-all zone with free standard shipping
+WITH zone AS (
+    INSERT INTO shipping_zones (name) VALUES ('Rest of World') RETURNING id
+)
+INSERT INTO shipping_methods (zone_id, name, type, rate)
+SELECT id, 'Standard Shipping', 'flat_rate', 0 FROM zone;
@@ -0,0 +1,4 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS refunded_amount;
+
+DROP TABLE IF EXISTS return_items;
+DROP TABLE IF EXISTS return_requests;
@@ -0,0 +1,34 @@
+CREATE TABLE return_requests (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'requested' CHECK (status IN ('requested', 'approved', 'received', 'refunded', 'rejected')),
+    reason TEXT NOT NULL,
+    rejection_reason TEXT,
+    refund_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    approved_at TIMESTAMP WITH TIME ZONE,
+    received_at TIMESTAMP WITH TIME ZONE,
+    refunded_at TIMESTAMP WITH TIME ZONE,
+    rejected_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_return_requests_order_id ON return_requests(order_id);
+CREATE INDEX idx_return_requests_user_id ON return_requests(user_id);
+CREATE INDEX idx_return_requests_status ON return_requests(status);
+CREATE INDEX idx_return_requests_deleted_at ON return_requests(deleted_at);
+
+CREATE TABLE return_items (
+    id SERIAL PRIMARY KEY,
+    return_request_id INTEGER NOT NULL REFERENCES return_requests(id) ON DELETE CASCADE,
+    order_item_id INTEGER NOT NULL REFERENCES order_items(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    UNIQUE(return_request_id, order_item_id)
+);
+
+CREATE INDEX idx_return_items_order_item_id ON return_items(order_item_id);
+
+ALTER TABLE orders
+    ADD COLUMN refunded_amount DECIMAL(10,2) NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS shipment_items;
+DROP TABLE IF EXISTS shipments;
@@ -0,0 +1,23 @@
+CREATE TABLE shipments (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    carrier VARCHAR(100) NOT NULL,
+    tracking_number VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'shipped' CHECK (status IN ('shipped', 'delivered')),
+    shipped_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_shipments_order_id ON shipments(order_id);
+
+CREATE TABLE shipment_items (
+    id SERIAL PRIMARY KEY,
+    shipment_id INTEGER NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    order_item_id INTEGER NOT NULL REFERENCES order_items(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    UNIQUE(shipment_id, order_item_id)
+);
+
+CREATE INDEX idx_shipment_items_order_item_id ON shipment_items(order_item_id);
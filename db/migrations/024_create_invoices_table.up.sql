@@ -0,0 +1,29 @@
+-- Invoice numbers must be gap-free, which a SEQUENCE doesn't guarantee, so
+-- they are taken from a single counter row updated in the confirming transaction
+CREATE TABLE invoice_counters (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    last_number BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE invoices (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    sequence_number BIGINT NOT NULL UNIQUE,
+    number VARCHAR(30) NOT NULL UNIQUE,
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    file_path VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Number the orders confirmed before invoicing existed in confirmation order
+INSERT INTO invoices (order_id, sequence_number, number, issued_at)
+SELECT id,
+       ROW_NUMBER() OVER (ORDER BY confirmed_at, id),
+       'INV-' || LPAD((ROW_NUMBER() OVER (ORDER BY confirmed_at, id))::TEXT, 6, '0'),
+       confirmed_at
+FROM orders
+WHERE confirmed_at IS NOT NULL;
+
+INSERT INTO invoice_counters (id, last_number)
+SELECT 1, COUNT(*) FROM invoices;
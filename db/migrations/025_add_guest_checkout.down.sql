@@ -0,0 +1,12 @@
+DELETE FROM orders WHERE user_id IS NULL;
+DELETE FROM carts WHERE user_id IS NULL;
+
+DROP INDEX IF EXISTS idx_orders_unclaimed_guest_email;
+
+ALTER TABLE orders
+    DROP CONSTRAINT IF EXISTS chk_orders_owner,
+    DROP COLUMN IF EXISTS guest_access_token_hash,
+    DROP COLUMN IF EXISTS guest_email,
+    ALTER COLUMN user_id SET NOT NULL;
+
+ALTER TABLE carts ALTER COLUMN user_id SET NOT NULL;
@@ -0,0 +1,11 @@
+-- Guest carts and orders have no user until a shopper registers
+ALTER TABLE carts ALTER COLUMN user_id DROP NOT NULL;
+
+ALTER TABLE orders
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN guest_email VARCHAR(255),
+    ADD COLUMN guest_access_token_hash VARCHAR(64) UNIQUE,
+    ADD CONSTRAINT chk_orders_owner CHECK (user_id IS NOT NULL OR guest_email IS NOT NULL);
+
+-- Unclaimed guest orders are looked up by email when a shopper registers
+CREATE INDEX idx_orders_unclaimed_guest_email ON orders (LOWER(guest_email)) WHERE user_id IS NULL;
@@ -0,0 +1,15 @@
+-- Stock held for a cart while it is checked out. Reservations stop counting
+-- once expires_at has passed and are deleted by a background sweeper.
+CREATE TABLE stock_reservations (
+    id SERIAL PRIMARY KEY,
+    cart_id INTEGER NOT NULL REFERENCES carts(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(cart_id, product_id)
+);
+
+CREATE INDEX idx_stock_reservations_product_id_expires_at ON stock_reservations(product_id, expires_at);
+CREATE INDEX idx_stock_reservations_expires_at ON stock_reservations(expires_at);
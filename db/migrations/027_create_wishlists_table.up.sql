@@ -0,0 +1,22 @@
+-- Named lists of products a user saved for later. A list can be shared
+-- read-only through its share_token, which is NULL while sharing is off.
+CREATE TABLE wishlists (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    share_token VARCHAR(64) UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_wishlists_user_id ON wishlists(user_id);
+
+CREATE TABLE wishlist_items (
+    id SERIAL PRIMARY KEY,
+    wishlist_id INTEGER NOT NULL REFERENCES wishlists(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(wishlist_id, product_id)
+);
+
+CREATE INDEX idx_wishlist_items_product_id ON wishlist_items(product_id);
@@ -0,0 +1,50 @@
+DROP TRIGGER IF EXISTS product_variants_search_vector_trigger ON product_variants;
+DROP FUNCTION IF EXISTS product_variants_search_vector_update();
+
+CREATE OR REPLACE FUNCTION products_search_vector_update()
+RETURNS trigger AS $$
+DECLARE
+    v_category_name text;
+BEGIN
+    SELECT c.name
+      INTO v_category_name
+      FROM categories c
+     WHERE c.id = NEW.category_id;
+
+    NEW.search_vector :=
+          setweight(to_tsvector('english', coalesce(NEW.name, '')), 'A')
+       || setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B')
+       || setweight(to_tsvector('simple',  coalesce(NEW.sku, '')), 'C')
+       || setweight(to_tsvector('english', coalesce(v_category_name, '')), 'D');
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMENT ON COLUMN products.search_vector IS
+  'Full-text search vector (A=name, B=description, C=sku, D=category name)';
+
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS variant_name,
+    DROP COLUMN IF EXISTS variant_id;
+
+-- Variant lines can't be told apart once variant_id is gone
+DELETE FROM stock_reservations WHERE variant_id IS NOT NULL;
+DROP INDEX IF EXISTS idx_stock_reservations_variant_id_expires_at;
+DROP INDEX IF EXISTS idx_stock_reservations_cart_id_product_id_variant_id;
+ALTER TABLE stock_reservations
+    DROP COLUMN IF EXISTS variant_id,
+    ADD CONSTRAINT stock_reservations_cart_id_product_id_key UNIQUE (cart_id, product_id);
+
+DELETE FROM cart_items WHERE variant_id IS NOT NULL;
+DROP INDEX IF EXISTS idx_cart_items_cart_id_product_id_variant_id;
+ALTER TABLE cart_items
+    DROP COLUMN IF EXISTS variant_id,
+    ADD CONSTRAINT cart_items_cart_id_product_id_key UNIQUE (cart_id, product_id);
+
+ALTER TABLE product_images DROP COLUMN IF EXISTS variant_id;
+
+DROP TABLE IF EXISTS product_variant_option_values;
+DROP TABLE IF EXISTS product_variants;
+DROP TABLE IF EXISTS product_option_values;
+DROP TABLE IF EXISTS product_options;
@@ -0,0 +1,118 @@
+-- Options a product comes in, such as size or colour, and their values
+CREATE TABLE product_options (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(product_id, name)
+);
+
+CREATE TABLE product_option_values (
+    id SERIAL PRIMARY KEY,
+    option_id INTEGER NOT NULL REFERENCES product_options(id) ON DELETE CASCADE,
+    value VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    UNIQUE(option_id, value)
+);
+
+-- Variants are sold with their own SKU and stock. A NULL price means the
+-- product's price is used.
+CREATE TABLE product_variants (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    sku VARCHAR(100) UNIQUE NOT NULL,
+    price DECIMAL(10,2) CHECK (price > 0),
+    stock INTEGER NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    is_active BOOLEAN DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_product_variants_product_id ON product_variants(product_id);
+CREATE INDEX idx_product_variants_deleted_at ON product_variants(deleted_at);
+
+CREATE TABLE product_variant_option_values (
+    variant_id INTEGER NOT NULL REFERENCES product_variants(id) ON DELETE CASCADE,
+    option_value_id INTEGER NOT NULL REFERENCES product_option_values(id) ON DELETE CASCADE,
+    PRIMARY KEY (variant_id, option_value_id)
+);
+
+ALTER TABLE product_images
+    ADD COLUMN variant_id INTEGER REFERENCES product_variants(id) ON DELETE SET NULL;
+
+-- Cart items, reservations and order items can be for a variant. The same
+-- product can be in a cart once per variant.
+ALTER TABLE cart_items
+    ADD COLUMN variant_id INTEGER REFERENCES product_variants(id) ON DELETE CASCADE,
+    DROP CONSTRAINT cart_items_cart_id_product_id_key;
+
+CREATE UNIQUE INDEX idx_cart_items_cart_id_product_id_variant_id
+    ON cart_items(cart_id, product_id, COALESCE(variant_id, 0));
+
+ALTER TABLE stock_reservations
+    ADD COLUMN variant_id INTEGER REFERENCES product_variants(id) ON DELETE CASCADE,
+    DROP CONSTRAINT stock_reservations_cart_id_product_id_key;
+
+CREATE UNIQUE INDEX idx_stock_reservations_cart_id_product_id_variant_id
+    ON stock_reservations(cart_id, product_id, COALESCE(variant_id, 0));
+CREATE INDEX idx_stock_reservations_variant_id_expires_at ON stock_reservations(variant_id, expires_at);
+
+ALTER TABLE order_items
+    ADD COLUMN variant_id INTEGER REFERENCES product_variants(id) ON DELETE SET NULL,
+    ADD COLUMN variant_name VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Index the SKUs of a product's variants along with its own
+CREATE OR REPLACE FUNCTION products_search_vector_update()
+RETURNS trigger AS $$
+DECLARE
+    v_category_name text;
+    v_variant_skus text;
+BEGIN
+    SELECT c.name
+      INTO v_category_name
+      FROM categories c
+     WHERE c.id = NEW.category_id;
+
+    SELECT string_agg(v.sku, ' ')
+      INTO v_variant_skus
+      FROM product_variants v
+     WHERE v.product_id = NEW.id
+       AND v.deleted_at IS NULL;
+
+    NEW.search_vector :=
+          setweight(to_tsvector('english', coalesce(NEW.name, '')), 'A')
+       || setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B')
+       || setweight(to_tsvector('simple',  coalesce(NEW.sku, '') || ' ' || coalesce(v_variant_skus, '')), 'C')
+       || setweight(to_tsvector('english', coalesce(v_category_name, '')), 'D');
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Recompute the product's search vector when its variants change. Setting
+-- sku to itself fires products_search_vector_trigger.
+CREATE OR REPLACE FUNCTION product_variants_search_vector_update()
+RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE products SET sku = sku WHERE id = NEW.product_id;
+    ELSIF TG_OP = 'UPDATE' THEN
+        UPDATE products SET sku = sku WHERE id IN (OLD.product_id, NEW.product_id);
+    ELSE
+        UPDATE products SET sku = sku WHERE id = OLD.product_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER product_variants_search_vector_trigger
+    AFTER INSERT OR UPDATE OF sku, product_id, deleted_at OR DELETE
+    ON product_variants
+    FOR EACH ROW
+EXECUTE FUNCTION product_variants_search_vector_update();
+
+COMMENT ON COLUMN products.search_vector IS
+  'Full-text search vector (A=name, B=description, C=sku and variant skus, D=category name)';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_categories_parent_id;
+DROP INDEX IF EXISTS idx_categories_slug;
+
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS slug,
+    DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,21 @@
+-- Categories can sit under a parent category, and have a slug for URLs
+ALTER TABLE categories
+    ADD COLUMN parent_id INTEGER REFERENCES categories(id) ON DELETE SET NULL,
+    ADD COLUMN slug VARCHAR(255);
+
+-- Derive slugs for existing categories from their names, keeping them unique
+UPDATE categories
+   SET slug = trim(both '-' from regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g'));
+
+UPDATE categories
+   SET slug = 'category-' || id
+ WHERE slug = '';
+
+UPDATE categories c
+   SET slug = c.slug || '-' || c.id
+ WHERE EXISTS (SELECT 1 FROM categories d WHERE d.slug = c.slug AND d.id < c.id);
+
+ALTER TABLE categories ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_categories_slug ON categories(slug) WHERE deleted_at IS NULL;
+CREATE INDEX idx_categories_parent_id ON categories(parent_id);
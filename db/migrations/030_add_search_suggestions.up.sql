@@ -0,0 +1,9 @@
+-- Trigram indexes for search suggestions: they serve both the prefix matches
+-- (LIKE) and the similarity matches that catch misspellings (<%)
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_products_name_trgm
+    ON products USING GIN (lower(name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_categories_name_trgm
+    ON categories USING GIN (lower(name) gin_trgm_ops);
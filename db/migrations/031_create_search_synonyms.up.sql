@@ -0,0 +1,8 @@
+CREATE TABLE search_synonyms (
+    id SERIAL PRIMARY KEY,
+    term VARCHAR(100) NOT NULL,
+    synonym VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(term, synonym)
+);
@@ -0,0 +1,10 @@
+DELETE FROM idempotency_keys WHERE user_id IS NULL;
+
+DROP INDEX IF EXISTS idx_idempotency_keys_cart_id_key;
+DROP INDEX IF EXISTS idx_idempotency_keys_user_id_key;
+
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT IF EXISTS chk_idempotency_keys_owner,
+    DROP COLUMN IF EXISTS cart_id,
+    ALTER COLUMN user_id SET NOT NULL,
+    ADD CONSTRAINT idempotency_keys_user_id_key_key UNIQUE (user_id, key);
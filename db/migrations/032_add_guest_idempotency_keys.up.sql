@@ -0,0 +1,9 @@
+-- Guest checkouts have no user, so their idempotency keys belong to the guest cart
+ALTER TABLE idempotency_keys
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN cart_id INTEGER REFERENCES carts(id) ON DELETE CASCADE,
+    DROP CONSTRAINT idempotency_keys_user_id_key_key,
+    ADD CONSTRAINT chk_idempotency_keys_owner CHECK ((user_id IS NULL) <> (cart_id IS NULL));
+
+CREATE UNIQUE INDEX idx_idempotency_keys_user_id_key ON idempotency_keys(user_id, key) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_idempotency_keys_cart_id_key ON idempotency_keys(cart_id, key) WHERE cart_id IS NOT NULL;
@@ -1,7 +1,8 @@
 #!/bin/bash
 
-# Create bucket
+# Create buckets
 awslocal s3 mb s3://ecommerce-uploads
+awslocal s3 mb s3://ecommerce-private
 
 # Create SQS queue
 awslocal sqs create-queue --queue-name ecommerce-events
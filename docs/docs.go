@@ -24,43 +24,53 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/login": {
-            "post": {
-                "description": "Authenticate user with email and password",
-                "consumes": [
-                    "application/json"
+        "/admin/coupons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Retrieve paginated list of coupons (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Coupons"
                 ],
-                "summary": "User login",
+                "summary": "Get coupons",
                 "parameters": [
                     {
-                        "description": "User login credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.LoginRequest"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Coupons retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CouponResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -68,57 +78,32 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Invalid credentials",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/auth/logout": {
-            "post": {
-                "description": "Invalidate refresh token and logout user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Authentication"
-                ],
-                "summary": "User logout",
-                "parameters": [
-                    {
-                        "description": "Refresh token to invalidate",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefreshTokenRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Logout successful",
+                    },
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request data",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
-            }
-        },
-        "/auth/refresh": {
+            },
             "post": {
-                "description": "Get a new access token using refresh token",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a promotion coupon (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -126,23 +111,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Coupons"
                 ],
-                "summary": "Refresh access token",
+                "summary": "Create a coupon",
                 "parameters": [
                     {
-                        "description": "Refresh token",
+                        "description": "Coupon data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefreshTokenRequest"
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateCouponRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Token refreshed successfully",
+                    "201": {
+                        "description": "Coupon created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -152,15 +137,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CouponResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
                     "401": {
-                        "description": "Invalid refresh token",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -168,43 +165,112 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/register": {
-            "post": {
-                "description": "Create a new user account with email and password",
-                "consumes": [
-                    "application/json"
+        "/admin/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Retrieve a paginated list of every user's orders with customer details (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Orders"
                 ],
-                "summary": "Register a new user",
+                "summary": "Get all orders",
                 "parameters": [
                     {
-                        "description": "User registration data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RegisterRequest"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "confirmed",
+                            "shipped",
+                            "delivered",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only orders created at or after this RFC 3339 time",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only orders created before this RFC 3339 time",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by customer email",
+                        "name": "user_email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum order total",
+                        "name": "min_total",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "total"
+                        ],
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "sort_order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User registered successfully",
+                    "200": {
+                        "description": "Orders retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -212,7 +278,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request data or user already exists",
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -220,24 +298,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/cart": {
+        "/admin/orders/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve current user's shopping cart with all items",
+                "description": "Retrieve detailed information about any user's order, including the customer (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cart"
+                    "Orders"
+                ],
+                "summary": "Get any order by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get user's cart",
                 "responses": {
                     "200": {
-                        "description": "Cart retrieved successfully",
+                        "description": "Order retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -247,21 +334,33 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid order ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
                     "404": {
-                        "description": "Cart not found",
+                        "description": "Order not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -269,56 +368,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/cart/items": {
-            "post": {
+        "/admin/orders/{id}/invoice.pdf": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add a product to the user's shopping cart",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Download the PDF invoice of any user's order (Admin only)",
                 "produces": [
-                    "application/json"
+                    "application/pdf"
                 ],
                 "tags": [
-                    "Cart"
+                    "Orders"
                 ],
-                "summary": "Add item to cart",
+                "summary": "Download any order invoice",
                 "parameters": [
                     {
-                        "description": "Item to add to cart",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddToCartRequest"
-                        }
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Item added to cart successfully",
+                        "description": "Invoice PDF",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Invalid request data or insufficient stock",
+                        "description": "Invalid order ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -328,18 +410,42 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Invoice not issued yet",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
                     }
                 }
             }
         },
-        "/cart/items/{id}": {
-            "put": {
+        "/admin/orders/{id}/shipments": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the quantity of an item in the user's cart",
+                "description": "Ship items of a confirmed order in one parcel with its carrier and tracking number. Without items everything not yet shipped is included. The payment is captured and, once every item has shipped, the order moves to shipped (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -347,30 +453,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cart"
+                    "Orders"
                 ],
-                "summary": "Update cart item quantity",
+                "summary": "Create a shipment",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Cart Item ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "New quantity",
+                        "description": "Carrier, tracking number and items to ship",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateCartItemRequest"
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateShipmentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Cart item updated successfully",
+                    "201": {
+                        "description": "Shipment created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -380,7 +486,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
                                         }
                                     }
                                 }
@@ -388,7 +494,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request data or insufficient stock",
+                        "description": "Invalid request data, order not confirmed or quantity not shippable",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -398,38 +504,73 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/orders/{id}/status": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove an item from the user's shopping cart",
+                "description": "Move an order to a new status (Admin only). Allowed transitions are pending→confirmed and pending/confirmed→cancelled. Orders become shipped and delivered through their shipments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Cart"
+                    "Orders"
                 ],
-                "summary": "Remove item from cart",
+                "summary": "Update order status",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Cart Item ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New order status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateOrderStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Item removed from cart successfully",
+                        "description": "Order status updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Invalid cart item ID",
+                        "description": "Invalid request data or status transition",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -439,27 +580,67 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
                     }
                 }
             }
         },
-        "/categories": {
+        "/admin/returns": {
             "get": {
-                "description": "Retrieve all active categories",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of every user's returns (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Returns"
+                ],
+                "summary": "Get all returns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "requested",
+                            "approved",
+                            "received",
+                            "refunded",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all categories",
                 "responses": {
                     "200": {
-                        "description": "Categories retrieved successfully",
+                        "description": "Returns retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
                                 },
                                 {
                                     "type": "object",
@@ -467,7 +648,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
                                             }
                                         }
                                     }
@@ -475,45 +656,54 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/returns/{id}/approve": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new product category (Admin only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Approve a requested return so the customer can send the items back (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Returns"
                 ],
-                "summary": "Create a new category",
+                "summary": "Approve a return",
                 "parameters": [
                     {
-                        "description": "Category data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateCategoryRequest"
-                        }
+                        "type": "integer",
+                        "description": "Return ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Category created successfully",
+                    "200": {
+                        "description": "Return approved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -523,7 +713,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
                                         }
                                     }
                                 }
@@ -531,7 +721,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Invalid return ID or status transition",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -551,45 +741,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories/{id}": {
-            "put": {
+        "/admin/returns/{id}/receive": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing category (Admin only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Record that the items of an approved return arrived. The items are put back in stock (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Returns"
                 ],
-                "summary": "Update a category",
+                "summary": "Mark a return received",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Category ID",
+                        "description": "Return ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Category update data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateCategoryRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category updated successfully",
+                        "description": "Return received successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -599,7 +777,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
                                         }
                                     }
                                 }
@@ -607,7 +785,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Invalid return ID or status transition",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -625,36 +803,64 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/returns/{id}/refund": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a category (Admin only)",
+                "description": "Refund a received return through the payment provider and record the refund against the order. Without an amount, what was paid for the returned items is refunded (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Categories"
+                    "Returns"
                 ],
-                "summary": "Delete a category",
+                "summary": "Refund a return",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Category ID",
+                        "description": "Return ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Refund amount",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefundReturnRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category deleted successfully",
+                        "description": "Return refunded successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Invalid category ID",
+                        "description": "Invalid amount, status transition or refund declined",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -674,90 +880,100 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders": {
-            "get": {
+        "/admin/returns/{id}/reject": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve paginated list of user's orders",
+                "description": "Reject a requested or approved return (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Returns"
                 ],
-                "summary": "Get user's orders",
+                "summary": "Reject a return",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Return ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Rejection reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RejectReturnRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Orders retrieved successfully",
+                        "description": "Return rejected successfully",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
-                                            }
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid request data or status transition",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/search-synonyms": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create an order from the current user's cart",
+                "description": "Retrieve the synonyms product searches are expanded with (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Search"
                 ],
-                "summary": "Create an order",
+                "summary": "Get search synonyms",
                 "responses": {
-                    "201": {
-                        "description": "Order created successfully",
+                    "200": {
+                        "description": "Search synonyms retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -767,55 +983,67 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.SearchSynonymResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Cart is empty or insufficient stock",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
-            }
-        },
-        "/orders/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve detailed information about a specific order",
+                "description": "Make product searches for a word also match a synonym of it, e.g. \"tv\" and \"television\". Synonyms work one way (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Search"
                 ],
-                "summary": "Get order by ID",
+                "summary": "Create a search synonym",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search synonym data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateSearchSynonymRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Order retrieved successfully",
+                    "201": {
+                        "description": "Search synonym created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -825,7 +1053,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.SearchSynonymResponse"
                                         }
                                     }
                                 }
@@ -833,7 +1061,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid order ID",
+                        "description": "Invalid request data, a term that isn't a single word, or duplicate synonym",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -844,8 +1072,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "404": {
-                        "description": "Order not found",
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -853,93 +1081,85 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
-            "get": {
-                "description": "Retrieve paginated list of active products",
+        "/admin/search-synonyms/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a search synonym (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Search"
                 ],
-                "summary": "Get all products",
+                "summary": "Delete a search synonym",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Search synonym ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Products retrieved successfully",
+                        "description": "Search synonym deleted successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Invalid search synonym ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/shipments/{id}/deliver": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new product (Admin only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Record that a parcel arrived. Once the order has fully shipped and every shipment is delivered, the order moves to delivered (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Orders"
                 ],
-                "summary": "Create a new product",
+                "summary": "Mark a shipment delivered",
                 "parameters": [
                     {
-                        "description": "Product data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductRequest"
-                        }
+                        "type": "integer",
+                        "description": "Shipment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Product created successfully",
+                    "200": {
+                        "description": "Shipment delivered successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -949,7 +1169,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
                                         }
                                     }
                                 }
@@ -957,7 +1177,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Invalid shipment ID or shipment already delivered",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -977,28 +1197,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}": {
+        "/admin/shipping-zones": {
             "get": {
-                "description": "Retrieve detailed information about a specific product",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all shipping zones with their locations and methods (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
-                ],
-                "summary": "Get a product by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Shipping"
                 ],
+                "summary": "Get shipping zones",
                 "responses": {
                     "200": {
-                        "description": "Product retrieved successfully",
+                        "description": "Shipping zones retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1008,34 +1224,43 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingZoneResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid product ID",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "404": {
-                        "description": "Product not found",
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing product (Admin only)",
+                "description": "Create a shipping zone covering countries or regions (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1043,30 +1268,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Shipping"
                 ],
-                "summary": "Update a product",
+                "summary": "Create a shipping zone",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Product update data",
+                        "description": "Shipping zone data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateProductRequest"
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateShippingZoneRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Product updated successfully",
+                    "201": {
+                        "description": "Shipping zone created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1076,7 +1294,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingZoneResponse"
                                         }
                                     }
                                 }
@@ -1102,36 +1320,65 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/shipping-zones/{id}/methods": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a product (Admin only)",
+                "description": "Add a flat rate, weight based or free over threshold shipping method to a zone (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Products"
+                    "Shipping"
                 ],
-                "summary": "Delete a product",
+                "summary": "Create a shipping method",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
+                        "description": "Shipping zone ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Shipping method data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateShippingMethodRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Product deleted successfully",
+                    "201": {
+                        "description": "Shipping method created successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingMethodResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Invalid product ID",
+                        "description": "Invalid request data or zone not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -1151,43 +1398,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}/images": {
-            "post": {
+        "/admin/tax-rates": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload an image for a product (Admin only)",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Retrieve all configured tax rates (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
-                ],
-                "summary": "Upload product image",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Image file",
-                        "name": "image",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "Tax"
                 ],
+                "summary": "Get tax rates",
                 "responses": {
                     "200": {
-                        "description": "Image uploaded successfully",
+                        "description": "Tax rates retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1197,9 +1425,9 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "additionalProperties": {
-                                                "type": "string"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.TaxRateResponse"
                                             }
                                         }
                                     }
@@ -1207,12 +1435,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid request or file",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -1224,77 +1446,56 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
                     }
                 }
-            }
-        },
-        "/search": {
-            "get": {
-                "description": "Search products using full-text search with ranking",
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the tax rate for a tax class in a country or region (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Tax"
                 ],
-                "summary": "Search products",
+                "summary": "Create a tax rate",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Filter by category ID",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Minimum price filter",
-                        "name": "min_price",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Maximum price filter",
-                        "name": "max_price",
-                        "in": "query"
+                        "description": "Tax rate data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateTaxRateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Search results",
+                    "201": {
+                        "description": "Tax rate created successfully",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductSearchResult"
-                                            }
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.TaxRateResponse"
                                         }
                                     }
                                 }
@@ -1302,13 +1503,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid search query",
+                        "description": "Invalid request data or duplicate rate",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
@@ -1316,24 +1523,85 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/profile": {
-            "get": {
+        "/admin/tax-rates/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get current authenticated user's profile information",
+                "description": "Delete a tax rate (Admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Tax"
+                ],
+                "summary": "Delete a tax rate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tax rate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get user profile",
                 "responses": {
                     "200": {
-                        "description": "Profile retrieved successfully",
+                        "description": "Tax rate deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tax rate ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Authenticate user with email and password. Items of the guest cart named by cart_token are merged into the user's cart",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "User login credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
                         "schema": {
                             "allOf": [
                                 {
@@ -1343,7 +1611,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UserResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse"
                                         }
                                     }
                                 }
@@ -1351,26 +1619,57 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "Invalidate refresh token and logout user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User logout",
+                "parameters": [
+                    {
+                        "description": "Refresh token to invalidate",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Logout successful",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "400": {
+                        "description": "Invalid request data",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Update current authenticated user's profile information",
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Get a new access token using refresh token",
                 "consumes": [
                     "application/json"
                 ],
@@ -1378,23 +1677,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Authentication"
                 ],
-                "summary": "Update user profile",
+                "summary": "Refresh access token",
                 "parameters": [
                     {
-                        "description": "Profile update data",
+                        "description": "Refresh token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateProfileRequest"
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefreshTokenRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Profile updated successfully",
+                        "description": "Token refreshed successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1404,212 +1703,5766 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UserResponse"
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid request data",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
-                        }
-                    },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid refresh token",
                         "schema": {
                             "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddToCartRequest": {
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Create a new user account with email and password. Items of the guest cart named by cart_token are merged into the new cart",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User registration data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User registered successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or user already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cart": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve current user's shopping cart with all items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Get user's cart",
+                "responses": {
+                    "200": {
+                        "description": "Cart retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Cart not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/coupon": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply a coupon code to the user's shopping cart. The coupon replaces any coupon already applied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Apply a coupon to the cart",
+                "parameters": [
+                    {
+                        "description": "Coupon code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ApplyCouponRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Coupon applied successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid, expired or inapplicable coupon",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the coupon applied to the user's shopping cart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Remove the coupon from the cart",
+                "responses": {
+                    "200": {
+                        "description": "Coupon removed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Cart not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/items": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a product to the user's shopping cart",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Add item to cart",
+                "parameters": [
+                    {
+                        "description": "Item to add to cart",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddToCartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item added to cart successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or insufficient stock",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/items/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the quantity of an item in the user's cart",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Update cart item quantity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cart Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New quantity",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateCartItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cart item updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or insufficient stock",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove an item from the user's shopping cart",
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Remove item from cart",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cart Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item removed from cart successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cart item ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/reservation": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hold the stock for everything in the user's cart while they check out. The reservation expires after a while, and reserving again replaces it and starts it over.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Reserve stock for the cart",
+                "responses": {
+                    "200": {
+                        "description": "Stock reserved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.StockReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Empty cart or insufficient stock",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/shipping-options": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Quote the shipping methods available for the user's cart to an address, cheapest first. Without an address_id or country the default shipping address is used.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Get shipping options for the cart",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Address book entry to ship to",
+                        "name": "address_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 3166-1 alpha-2 country code to ship to",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region to ship to",
+                        "name": "region",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shipping options retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingOptionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid address, empty cart or no shipping available",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Retrieve all active categories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get all categories",
+                "responses": {
+                    "200": {
+                        "description": "Categories retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new product category (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Create a new category",
+                "parameters": [
+                    {
+                        "description": "Category data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Category created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data, parent category not found or slug already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/tree": {
+            "get": {
+                "description": "Retrieve the active categories nested under their parent categories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get the category tree",
+                "responses": {
+                    "200": {
+                        "description": "Category tree retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryTreeResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing category, moving it under another parent category (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Update a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category update data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data, parent category not found, moved under itself or slug already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a category without subcategories (Admin only)",
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Delete a category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid category ID or category has subcategories",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/cart": {
+            "get": {
+                "security": [
+                    {
+                        "CartToken": []
+                    }
+                ],
+                "description": "Retrieve the guest cart with all items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Get guest cart",
+                "responses": {
+                    "200": {
+                        "description": "Cart retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid cart token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Cart not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Start an empty cart for a shopper without an account. The returned cart token identifies the cart in the X-Cart-Token header of the other guest routes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Create a guest cart",
+                "responses": {
+                    "201": {
+                        "description": "Guest cart created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestCartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/cart/items": {
+            "post": {
+                "security": [
+                    {
+                        "CartToken": []
+                    }
+                ],
+                "description": "Add a product to the guest cart",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Add item to guest cart",
+                "parameters": [
+                    {
+                        "description": "Item to add to cart",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddToCartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item added to cart successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or insufficient stock",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid cart token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/cart/items/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "CartToken": []
+                    }
+                ],
+                "description": "Update the quantity of an item in the guest cart",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Update guest cart item quantity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cart Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New quantity",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateCartItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cart item updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or insufficient stock",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid cart token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "CartToken": []
+                    }
+                ],
+                "description": "Remove an item from the guest cart",
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Remove item from guest cart",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Cart Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item removed from cart successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cart item ID or cart not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid cart token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/cart/reservation": {
+            "post": {
+                "security": [
+                    {
+                        "CartToken": []
+                    }
+                ],
+                "description": "Hold the stock for everything in the guest cart during checkout. The reservation expires after a while, and reserving again replaces it and starts it over.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Reserve stock for the guest cart",
+                "responses": {
+                    "200": {
+                        "description": "Stock reserved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.StockReservationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Empty cart or insufficient stock",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid cart token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/cart/shipping-options": {
+            "get": {
+                "security": [
+                    {
+                        "CartToken": []
+                    }
+                ],
+                "description": "Quote the shipping methods available for the guest cart to a country, cheapest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Get shipping options for the guest cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ISO 3166-1 alpha-2 country code to ship to",
+                        "name": "country",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region to ship to",
+                        "name": "region",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shipping options retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingOptionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid country, empty cart or no shipping available",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid cart token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/checkout": {
+            "post": {
+                "security": [
+                    {
+                        "CartToken": []
+                    }
+                ],
+                "description": "Create an order from the guest cart for an email and address. The response carries the order access token that is needed to look the order up again. Signed-in shoppers can claim the order for their account with its email and access token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Check out as a guest",
+                "parameters": [
+                    {
+                        "description": "Contact email, addresses and payment token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestCheckoutRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries of this request replay the original response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Order created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestOrderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data, empty cart, insufficient stock or payment declined",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid cart token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key reused with a different request or still in progress",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/orders/lookup": {
+            "post": {
+                "description": "Retrieve a guest order by the email it was placed with and its order access token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Guest"
+                ],
+                "summary": "Look up a guest order",
+                "parameters": [
+                    {
+                        "description": "Email and order access token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestOrderLookupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve paginated list of user's orders",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Get user's orders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Orders retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create an order from the current user's cart. Addresses default to the user's saved defaults when omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Create an order",
+                "parameters": [
+                    {
+                        "description": "Shipping and billing address selection and payment token",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique key that makes retries of this request replay the original response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Order created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Cart is empty, insufficient stock, no shipping address or payment declined",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key reused with a different request or still in progress",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move an order placed as a guest to the current user's account. It takes the email the order was placed with and its order access token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Claim a guest order",
+                "parameters": [
+                    {
+                        "description": "Email and order access token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestOrderLookupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order claimed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve detailed information about a specific order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Get order by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid order ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel one of the current user's orders while it is pending or confirmed. The stock of every item is restored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Cancel an order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CancelOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order cancelled successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or order can no longer be cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/invoice.pdf": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the PDF invoice of one of the current user's orders. Invoices are issued when an order is confirmed",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Download order invoice",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice PDF",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid order ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Invoice not issued yet",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/reorder": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy the products of one of the current user's orders into their cart. Products no longer sold are skipped and quantities are reduced to the stock left; those lines are listed in adjustments",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Reorder a past order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order added to cart successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReorderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid order ID or order not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/returns": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Open a return for items of one of the current user's delivered orders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Returns"
+                ],
+                "summary": "Request a return",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Items to return and the reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateReturnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Return requested successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data, order not delivered or quantity not returnable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/webhook": {
+            "post": {
+                "description": "Apply an asynchronous payment result from the payment provider. Pending orders are confirmed or cancelled accordingly.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Receive a payment webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hex HMAC-SHA256 of the raw body using the webhook secret",
+                        "name": "X-Payment-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook processed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid webhook signature",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Retrieve paginated list of active products, optionally in a category and the categories under it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get all products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also include products in the categories under category_id",
+                        "name": "include_subcategories",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "price_asc",
+                            "price_desc",
+                            "popularity"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Products retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new product (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Product data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "description": "Retrieve detailed information about a specific product",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get a product by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid product ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing product (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product update data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a product (Admin only)",
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid product ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/images": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload an image for a product, or for one of its variants (Admin only)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Upload product image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant the image shows",
+                        "name": "variant_id",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image uploaded successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or file",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/options": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add an option such as size or colour, with its values, to a product (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Add a product option",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Option name and values",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductOptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product option created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a variant with its own SKU, price and stock for one value of each of the product's options (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Add a product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductVariantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product variant created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductVariantResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/variants/{variant_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a variant's price, stock and whether it is sold (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update a product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant ID",
+                        "name": "variant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant update data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateProductVariantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product variant updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductVariantResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a variant of a product and take it out of carts (Admin only)",
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant ID",
+                        "name": "variant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product variant deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Variant not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/returns": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve paginated list of the current user's returns",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Returns"
+                ],
+                "summary": "Get user's returns",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Returns retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/returns/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve one of the current user's returns",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Returns"
+                ],
+                "summary": "Get return by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Return ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Return retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid return ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Return not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Search products using full-text search with ranking. Query words are also matched by their search synonyms. The response includes facet counts by category, price range, stock and variant attribute, each applying every filter but its own",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Search products",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also match products in the categories under category_id",
+                        "name": "include_subcategories",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum price filter",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum price filter",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products in stock, or only those out of stock",
+                        "name": "in_stock",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Variant attribute filter as option:value, e.g. Size:M",
+                        "name": "attribute",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "relevance",
+                            "newest",
+                            "price_asc",
+                            "price_desc",
+                            "popularity"
+                        ],
+                        "type": "string",
+                        "default": "relevance",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.SearchResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductSearchResult"
+                                            }
+                                        },
+                                        "facets": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.SearchFacetsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid search query",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/suggest": {
+            "get": {
+                "description": "Suggest products and categories as a search query is typed. Names that start with the query, or have a word that does, come first, followed by names resembling it so misspellings still find matches",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Search suggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Partly typed search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Number of products and of categories to suggest",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search suggestions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.SearchSuggestionsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid search query",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/addresses": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the current user's saved addresses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Get user's addresses",
+                "responses": {
+                    "200": {
+                        "description": "Addresses retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddressResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Save a new address to the current user's address book",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Create an address",
+                "parameters": [
+                    {
+                        "description": "Address data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateAddressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Address created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddressResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/addresses/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update one of the current user's saved addresses",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Update an address",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Address ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Address data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateAddressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Address updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddressResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove one of the current user's saved addresses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Addresses"
+                ],
+                "summary": "Delete an address",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Address ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Address deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid address ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Address not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get current authenticated user's profile information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "Profile retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update current authenticated user's profile information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update user profile",
+                "parameters": [
+                    {
+                        "description": "Profile update data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profile updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/wishlists": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the current user's wishlists with their items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Get user's wishlists",
+                "responses": {
+                    "200": {
+                        "description": "Wishlists retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new named wishlist for the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Create a wishlist",
+                "parameters": [
+                    {
+                        "description": "Wishlist name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateWishlistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Wishlist created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/wishlists/shared/{token}": {
+            "get": {
+                "description": "Retrieve a wishlist its owner shared, using the token from the share link. No login is needed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Get a shared wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Wishlist retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Wishlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/wishlists/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve one of the current user's wishlists with its items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Get wishlist by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Wishlist retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid wishlist ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Wishlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the name of one of the current user's wishlists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Rename a wishlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Wishlist name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateWishlistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Wishlist updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or wishlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete one of the current user's wishlists and everything on it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Delete a wishlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Wishlist deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid wishlist ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Wishlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/wishlists/{id}/items": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Save a product to one of the current user's wishlists. Adding a product that is already on the list leaves it unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Add a product to a wishlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddWishlistItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item added to wishlist successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data, wishlist or product not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/wishlists/{id}/items/{item_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Take a product off one of the current user's wishlists",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Remove an item from a wishlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Wishlist item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item removed from wishlist successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid wishlist or item ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Wishlist item not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/wishlists/{id}/items/{item_id}/move-to-cart": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a wishlist item's product to the cart, with the cart's stock checks, and take it off the wishlist. Without a quantity one is added",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Move a wishlist item to the cart",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Wishlist item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quantity to add to the cart",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.MoveWishlistItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item moved to cart successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data, item not found or insufficient stock",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/wishlists/{id}/share": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turn on sharing for one of the current user's wishlists. Anyone with the returned share token can view the list at /wishlists/shared/{token}. A list that is already shared keeps its token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Share a wishlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Wishlist shared successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid wishlist ID or wishlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turn off sharing for one of the current user's wishlists, so its share link stops working",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlists"
+                ],
+                "summary": "Stop sharing a wishlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Wishlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Wishlist unshared successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid wishlist ID or wishlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddToCartRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "variant_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddWishlistItemRequest": {
+            "type": "object",
+            "required": [
+                "product_id"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.AddressResponse": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_default_billing": {
+                    "type": "boolean"
+                },
+                "is_default_shipping": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "line1": {
+                    "type": "string"
+                },
+                "line2": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "postal_code": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ApplyCouponRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.AttributeFacetResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "option": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "cart_adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartMergeAdjustmentResponse"
+                    }
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UserResponse"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CancelOrderRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartDiscountResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartItemResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "product": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "tax_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "variant": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductVariantResponse"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartMergeAdjustmentResponse": {
+            "type": "object",
+            "properties": {
+                "added_quantity": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "product_name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requested_quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse": {
+            "type": "object",
+            "properties": {
+                "cart_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartItemResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartDiscountResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "prices_include_tax": {
+                    "type": "boolean"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "tax_total": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "total": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryFacetResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategorySuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryTreeResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryTreeResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CouponResponse": {
+            "type": "object",
+            "properties": {
+                "amount_off": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "min_cart_value": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "percent_off": {
+                    "type": "integer"
+                },
+                "product_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "times_used": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "usage_limit": {
+                    "type": "integer"
+                },
+                "usage_limit_per_user": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateAddressRequest": {
+            "type": "object",
+            "required": [
+                "city",
+                "country",
+                "first_name",
+                "last_name",
+                "line1",
+                "postal_code"
+            ],
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "country": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "is_default_billing": {
+                    "type": "boolean"
+                },
+                "is_default_shipping": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "last_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "line1": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "line2": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "postal_code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateCouponRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "type"
+            ],
+            "properties": {
+                "amount_off": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "min_cart_value": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "percent_off": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "product_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "percentage",
+                        "fixed_amount",
+                        "free_shipping"
+                    ]
+                },
+                "usage_limit": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "usage_limit_per_user": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateOrderRequest": {
+            "type": "object",
+            "properties": {
+                "billing_address_id": {
+                    "type": "integer"
+                },
+                "payment_token": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "shipping_address_id": {
+                    "type": "integer"
+                },
+                "shipping_method_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductOptionRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "values"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "values": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "name",
+                "sku"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "tax_class": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "weight_grams": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductVariantRequest": {
+            "type": "object",
+            "required": [
+                "option_values",
+                "sku"
+            ],
+            "properties": {
+                "option_values": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.VariantOptionValueRequest"
+                    }
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "sku": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "stock": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateReturnRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "reason"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnItemRequest"
+                    }
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateSearchSynonymRequest": {
+            "type": "object",
+            "required": [
+                "synonym",
+                "term"
+            ],
+            "properties": {
+                "synonym": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "term": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateShipmentRequest": {
+            "type": "object",
+            "required": [
+                "carrier",
+                "tracking_number"
+            ],
+            "properties": {
+                "carrier": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShipmentItemRequest"
+                    }
+                },
+                "tracking_number": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateShippingMethodRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "free_threshold": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "per_kg_rate": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "rate": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "flat_rate",
+                        "weight_based",
+                        "free_over_threshold"
+                    ]
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateShippingZoneRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingZoneLocationRequest"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateTaxRateRequest": {
+            "type": "object",
+            "required": [
+                "country",
+                "tax_class"
+            ],
+            "properties": {
+                "basis_points": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0
+                },
+                "country": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "tax_class": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateWishlistRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestAddressRequest": {
+            "type": "object",
+            "required": [
+                "city",
+                "country",
+                "first_name",
+                "last_name",
+                "line1",
+                "postal_code"
+            ],
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "country": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "last_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "line1": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "line2": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "postal_code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestCartResponse": {
+            "type": "object",
+            "properties": {
+                "cart": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                },
+                "cart_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestCheckoutRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "shipping_address"
+            ],
+            "properties": {
+                "billing_address": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestAddressRequest"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "payment_token": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "shipping_address": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestAddressRequest"
+                },
+                "shipping_method_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestOrderLookupRequest": {
+            "type": "object",
+            "required": [
+                "access_token",
+                "email"
+            ],
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.GuestOrderResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "order": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "cart_token": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.MoveWishlistItemRequest": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "variant_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderAddressResponse": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "line1": {
+                    "type": "string"
+                },
+                "line2": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "postal_code": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderCustomerResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderItemResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "line_total": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "product": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "product_name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "tax_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "tax_rate_basis_points": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "variant_id": {
+                    "type": "integer"
+                },
+                "variant_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "billing_address": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderAddressResponse"
+                },
+                "cancellation_reason": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "confirmed_at": {
+                    "type": "string"
+                },
+                "coupon_code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderCustomerResponse"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "guest_email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "invoice_number": {
+                    "type": "string"
+                },
+                "order_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderItemResponse"
+                    }
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.PaymentResponse"
+                    }
+                },
+                "prices_include_tax": {
+                    "type": "boolean"
+                },
+                "refunded_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "shipments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShipmentResponse"
+                    }
+                },
+                "shipped_at": {
+                    "type": "string"
+                },
+                "shipping_address": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderAddressResponse"
+                },
+                "shipping_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "shipping_method_id": {
+                    "type": "integer"
+                },
+                "shipping_method_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subtotal_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "tax_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "total_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.PaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.PriceRangeFacetResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "max": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "min": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductImageResponse": {
+            "type": "object",
+            "properties": {
+                "alt_text": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_primary": {
+                    "type": "boolean"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "variant_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductOptionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse": {
+            "type": "object",
+            "properties": {
+                "available_stock": {
+                    "type": "integer"
+                },
+                "category": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductImageResponse"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductOptionResponse"
+                    }
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "tax_class": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductVariantResponse"
+                    }
+                },
+                "weight_grams": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductSearchResult": {
+            "type": "object",
+            "properties": {
+                "available_stock": {
+                    "type": "integer"
+                },
+                "category": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductImageResponse"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductOptionResponse"
+                    }
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "tax_class": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductVariantResponse"
+                    }
+                },
+                "weight_grams": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductSuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductVariantResponse": {
+            "type": "object",
+            "properties": {
+                "available_stock": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductImageResponse"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "option_values": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.VariantOptionValueResponse"
+                    }
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "sku": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefundReturnRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.RegisterRequest": {
             "type": "object",
             "required": [
-                "product_id",
-                "quantity"
+                "email",
+                "first_name",
+                "last_name",
+                "password"
+            ],
+            "properties": {
+                "cart_token": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.RejectReturnRequest": {
+            "type": "object",
+            "required": [
+                "reason"
             ],
             "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReorderAdjustmentResponse": {
+            "type": "object",
+            "properties": {
+                "added_quantity": {
+                    "type": "integer"
+                },
+                "order_item_id": {
+                    "type": "integer"
+                },
                 "product_id": {
                     "type": "integer"
                 },
+                "product_name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requested_quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReorderResponse": {
+            "type": "object",
+            "properties": {
+                "adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReorderAdjustmentResponse"
+                    }
+                },
+                "cart": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnItemRequest": {
+            "type": "object",
+            "required": [
+                "order_item_id",
+                "quantity"
+            ],
+            "properties": {
+                "order_item_id": {
+                    "type": "integer"
+                },
                 "quantity": {
                     "type": "integer",
                     "minimum": 1
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.AuthResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnItemResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "refresh_token": {
+                "order_item_id": {
+                    "type": "integer"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "product_name": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.UserResponse"
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartItemResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnResponse": {
             "type": "object",
             "properties": {
+                "approved_at": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "product": {
-                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ReturnItemResponse"
+                    }
                 },
-                "quantity": {
+                "order_id": {
                     "type": "integer"
                 },
-                "subtotal": {
-                    "type": "number"
+                "reason": {
+                    "type": "string"
+                },
+                "received_at": {
+                    "type": "string"
+                },
+                "refund_amount": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "refunded_at": {
+                    "type": "string"
+                },
+                "rejected_at": {
+                    "type": "string"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.SearchFacetsResponse": {
             "type": "object",
             "properties": {
-                "cart_items": {
+                "attributes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CartItemResponse"
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.AttributeFacetResponse"
+                    }
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryFacetResponse"
+                    }
+                },
+                "price_ranges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.PriceRangeFacetResponse"
+                    }
+                },
+                "stock": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.StockFacetResponse"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.SearchSuggestionsResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategorySuggestionResponse"
                     }
                 },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductSuggestionResponse"
+                    }
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.SearchSynonymResponse": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "total": {
-                    "type": "number"
+                "synonym": {
+                    "type": "string"
+                },
+                "term": {
+                    "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShipmentItemRequest": {
+            "type": "object",
+            "required": [
+                "order_item_id",
+                "quantity"
+            ],
+            "properties": {
+                "order_item_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShipmentItemResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "order_item_id": {
+                    "type": "integer"
+                },
+                "product_name": {
+                    "type": "string"
                 },
-                "user_id": {
+                "quantity": {
                     "type": "integer"
+                },
+                "sku": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShipmentResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "carrier": {
                     "type": "string"
                 },
-                "description": {
+                "delivered_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShipmentItemResponse"
+                    }
                 },
-                "name": {
+                "shipped_at": {
                     "type": "string"
                 },
-                "updated_at": {
+                "status": {
+                    "type": "string"
+                },
+                "tracking_number": {
                     "type": "string"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateCategoryRequest": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingMethodResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "description": {
+                "created_at": {
                     "type": "string"
                 },
+                "free_threshold": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
+                },
+                "per_kg_rate": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "rate": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "zone_id": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.CreateProductRequest": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingOptionResponse": {
             "type": "object",
-            "required": [
-                "category_id",
-                "name",
-                "price",
-                "sku"
-            ],
             "properties": {
-                "category_id": {
-                    "type": "integer"
+                "cost": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
                 },
-                "description": {
-                    "type": "string"
+                "method_id": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
-                },
-                "sku": {
+                "type": {
                     "type": "string"
                 },
-                "stock": {
-                    "type": "integer",
-                    "minimum": 0
+                "zone_name": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.LoginRequest": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingZoneLocationRequest": {
             "type": "object",
             "required": [
-                "email",
-                "password"
+                "country"
             ],
             "properties": {
-                "email": {
+                "country": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string"
+                "region": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderItemResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingZoneLocationResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "country": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "price": {
-                    "type": "number"
-                },
-                "product": {
-                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
-                },
-                "quantity": {
-                    "type": "integer"
+                "region": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingZoneResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -1618,172 +7471,152 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
-                "order_items": {
+                "locations": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.OrderItemResponse"
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingZoneLocationResponse"
                     }
                 },
-                "status": {
-                    "type": "string"
+                "methods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ShippingMethodResponse"
+                    }
                 },
-                "total_amount": {
-                    "type": "number"
+                "name": {
+                    "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
-                },
-                "user_id": {
-                    "type": "integer"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductImageResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.StockFacetResponse": {
             "type": "object",
             "properties": {
-                "alt_text": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "id": {
+                "in_stock": {
                     "type": "integer"
                 },
-                "is_primary": {
-                    "type": "boolean"
-                },
-                "url": {
-                    "type": "string"
+                "out_of_stock": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.StockReservationItemResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
-                },
-                "category_id": {
+                "product_id": {
                     "type": "integer"
                 },
-                "created_at": {
+                "product_name": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "quantity": {
+                    "type": "integer"
                 },
-                "id": {
+                "variant_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.StockReservationResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
                 },
-                "images": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductImageResponse"
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.StockReservationItemResponse"
                     }
-                },
-                "is_active": {
-                    "type": "boolean"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "number"
-                },
-                "sku": {
-                    "type": "string"
-                },
-                "stock": {
-                    "type": "integer"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductSearchResult": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.TaxRateResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.CategoryResponse"
-                },
-                "category_id": {
+                "basis_points": {
                     "type": "integer"
                 },
-                "created_at": {
+                "country": {
                     "type": "string"
                 },
-                "description": {
+                "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductImageResponse"
-                    }
-                },
-                "is_active": {
-                    "type": "boolean"
-                },
                 "name": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
-                },
-                "rank": {
-                    "type": "number"
-                },
-                "sku": {
+                "region": {
                     "type": "string"
                 },
-                "stock": {
-                    "type": "integer"
+                "tax_class": {
+                    "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.RefreshTokenRequest": {
-            "type": "object",
-            "required": [
-                "refresh_token"
-            ],
-            "properties": {
-                "refresh_token": {
-                    "type": "string"
-                }
-            }
-        },
-        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.RegisterRequest": {
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateAddressRequest": {
             "type": "object",
             "required": [
-                "email",
+                "city",
+                "country",
                 "first_name",
                 "last_name",
-                "password"
+                "line1",
+                "postal_code"
             ],
             "properties": {
-                "email": {
+                "city": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "country": {
                     "type": "string"
                 },
                 "first_name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "is_default_billing": {
+                    "type": "boolean"
+                },
+                "is_default_shipping": {
+                    "type": "boolean"
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 100
                 },
                 "last_name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "password": {
+                "line1": {
                     "type": "string",
-                    "minLength": 8
+                    "maxLength": 255
+                },
+                "line2": {
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "phone": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "postal_code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
@@ -1813,6 +7646,29 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateOrderStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "confirmed",
+                        "cancelled"
+                    ]
                 }
             }
         },
@@ -1820,8 +7676,7 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "category_id",
-                "name",
-                "price"
+                "name"
             ],
             "properties": {
                 "category_id": {
@@ -1837,7 +7692,30 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "price": {
-                    "type": "number"
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
+                },
+                "stock": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "tax_class": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "weight_grams": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateProductVariantRequest": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON"
                 },
                 "stock": {
                     "type": "integer",
@@ -1863,6 +7741,18 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.UpdateWishlistRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
         "github_com_vijayaragavanmg_learning-go-shop_internal_dto.UserResponse": {
             "type": "object",
             "properties": {
@@ -1889,6 +7779,85 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.VariantOptionValueRequest": {
+            "type": "object",
+            "required": [
+                "option",
+                "value"
+            ],
+            "properties": {
+                "option": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.VariantOptionValueResponse": {
+            "type": "object",
+            "properties": {
+                "option": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistItemResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "product": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.ProductResponse"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_dto.WishlistItemResponse"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "share_token": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_money.JSON": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "12.50"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                }
+            }
+        },
         "github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginatedResponse": {
             "type": "object",
             "properties": {
@@ -1938,6 +7907,25 @@ const docTemplate = `{
                     "type": "boolean"
                 }
             }
+        },
+        "github_com_vijayaragavanmg_learning-go-shop_internal_utils.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "type": "string"
+                },
+                "facets": {},
+                "message": {
+                    "type": "string"
+                },
+                "meta": {
+                    "$ref": "#/definitions/github_com_vijayaragavanmg_learning-go-shop_internal_utils.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -1946,6 +7934,12 @@ const docTemplate = `{
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
+        },
+        "CartToken": {
+            "description": "Guest cart token returned when the guest cart is created.",
+            "type": "apiKey",
+            "name": "X-Cart-Token",
+            "in": "header"
         }
     }
 }`
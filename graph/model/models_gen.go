@@ -36,3 +36,23 @@ type ProductEdge struct {
 
 type Query struct {
 }
+
+type ReturnConnection struct {
+	Edges    []*ReturnEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type ReturnEdge struct {
+	Node *dto.ReturnResponse `json:"node"`
+}
+
+type SearchConnection struct {
+	Edges    []*SearchEdge             `json:"edges"`
+	Facets   *dto.SearchFacetsResponse `json:"facets"`
+	PageInfo *PageInfo                 `json:"pageInfo"`
+}
+
+type SearchEdge struct {
+	Node *dto.ProductResponse `json:"node"`
+	Rank float64              `json:"rank"`
+}
@@ -12,25 +12,37 @@ import (
 // here.
 
 type Resolver struct {
-	authService    services.AuthServiceInterface
-	userService    services.UserServiceInterface
-	productService services.ProductServiceInterface
-	cartService    services.CartServiceInterface
-	orderService   services.OrderServiceInterface
+	authService     services.AuthServiceInterface
+	userService     services.UserServiceInterface
+	productService  services.ProductServiceInterface
+	cartService     services.CartServiceInterface
+	orderService    services.OrderServiceInterface
+	addressService  services.AddressServiceInterface
+	shippingService services.ShippingServiceInterface
+	returnService   services.ReturnServiceInterface
+	wishlistService services.WishlistServiceInterface
 }
 
 func NewResolver(authService services.AuthServiceInterface,
 	userService services.UserServiceInterface,
 	productService services.ProductServiceInterface,
 	cartService services.CartServiceInterface,
-	orderService services.OrderServiceInterface) *Resolver {
+	orderService services.OrderServiceInterface,
+	addressService services.AddressServiceInterface,
+	shippingService services.ShippingServiceInterface,
+	returnService services.ReturnServiceInterface,
+	wishlistService services.WishlistServiceInterface) *Resolver {
 
 	return &Resolver{
-		authService:    authService,
-		userService:    userService,
-		productService: productService,
-		cartService:    cartService,
-		orderService:   orderService,
+		authService:     authService,
+		userService:     userService,
+		productService:  productService,
+		cartService:     cartService,
+		orderService:    orderService,
+		addressService:  addressService,
+		shippingService: shippingService,
+		returnService:   returnService,
+		wishlistService: wishlistService,
 	}
 
 }
@@ -68,6 +68,61 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input dto.UpdatePr
 	return user, nil
 }
 
+// CreateAddress is the resolver for the createAddress field.
+func (r *mutationResolver) CreateAddress(ctx context.Context, input dto.CreateAddressRequest) (*dto.AddressResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	address, err := r.addressService.CreateAddress(userID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create address: %w", err)
+	}
+
+	return address, nil
+}
+
+// UpdateAddress is the resolver for the updateAddress field.
+func (r *mutationResolver) UpdateAddress(ctx context.Context, id string, input dto.UpdateAddressRequest) (*dto.AddressResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	addressID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address ID: %w", err)
+	}
+
+	address, err := r.addressService.UpdateAddress(userID, addressID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update address: %w", err)
+	}
+
+	return address, nil
+}
+
+// DeleteAddress is the resolver for the deleteAddress field.
+func (r *mutationResolver) DeleteAddress(ctx context.Context, id string) (bool, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return false, ErrUnauthorized
+	}
+
+	addressID, err := r.parseID(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid address ID: %w", err)
+	}
+
+	err = r.addressService.DeleteAddress(userID, addressID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete address: %w", err)
+	}
+
+	return true, nil
+}
+
 // CreateCategory is the resolver for the createCategory field.
 func (r *mutationResolver) CreateCategory(ctx context.Context, input dto.CreateCategoryRequest) (*dto.CategoryResponse, error) {
 	if !IsAdminFromContext(ctx) {
@@ -172,6 +227,92 @@ func (r *mutationResolver) DeleteProduct(ctx context.Context, id string) (bool,
 	return true, nil
 }
 
+// CreateProductOption is the resolver for the createProductOption field.
+func (r *mutationResolver) CreateProductOption(ctx context.Context, id string, input dto.CreateProductOptionRequest) (*dto.ProductResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	productID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product ID: %w", err)
+	}
+
+	product, err := r.productService.CreateProductOption(productID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product option: %w", err)
+	}
+
+	return product, nil
+}
+
+// CreateProductVariant is the resolver for the createProductVariant field.
+func (r *mutationResolver) CreateProductVariant(ctx context.Context, id string, input dto.CreateProductVariantRequest) (*dto.ProductVariantResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	productID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product ID: %w", err)
+	}
+
+	variant, err := r.productService.CreateProductVariant(productID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product variant: %w", err)
+	}
+
+	return variant, nil
+}
+
+// UpdateProductVariant is the resolver for the updateProductVariant field.
+func (r *mutationResolver) UpdateProductVariant(ctx context.Context, id string, variantID string, input dto.UpdateProductVariantRequest) (*dto.ProductVariantResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	productID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product ID: %w", err)
+	}
+
+	parsedVariantID, err := r.parseID(variantID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid variant ID: %w", err)
+	}
+
+	variant, err := r.productService.UpdateProductVariant(productID, parsedVariantID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update product variant: %w", err)
+	}
+
+	return variant, nil
+}
+
+// DeleteProductVariant is the resolver for the deleteProductVariant field.
+func (r *mutationResolver) DeleteProductVariant(ctx context.Context, id string, variantID string) (bool, error) {
+	if !IsAdminFromContext(ctx) {
+		return false, ErrUnauthorized
+	}
+
+	productID, err := r.parseID(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid product ID: %w", err)
+	}
+
+	parsedVariantID, err := r.parseID(variantID)
+	if err != nil {
+		return false, fmt.Errorf("invalid variant ID: %w", err)
+	}
+
+	err = r.productService.DeleteProductVariant(productID, parsedVariantID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete product variant: %w", err)
+	}
+
+	return true, nil
+}
+
 // AddToCart is the resolver for the addToCart field.
 func (r *mutationResolver) AddToCart(ctx context.Context, input dto.AddToCartRequest) (*dto.CartResponse, error) {
 	userID, err := GetUserIDFromContext(ctx)
@@ -227,157 +368,868 @@ func (r *mutationResolver) RemoveFromCart(ctx context.Context, id string) (bool,
 	return true, nil
 }
 
-// CreateOrder is the resolver for the createOrder field.
-func (r *mutationResolver) CreateOrder(ctx context.Context) (*dto.OrderResponse, error) {
+// ApplyCoupon is the resolver for the applyCoupon field.
+func (r *mutationResolver) ApplyCoupon(ctx context.Context, input dto.ApplyCouponRequest) (*dto.CartResponse, error) {
 	userID, err := GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, ErrUnauthorized
 	}
 
-	order, err := r.orderService.CreateOrder(userID)
+	cart, err := r.cartService.ApplyCoupon(userID, &input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create order: %w", err)
+		return nil, fmt.Errorf("failed to apply coupon: %w", err)
 	}
 
-	return order, nil
+	return cart, nil
 }
 
-// Me is the resolver for the me field.
-func (r *queryResolver) Me(ctx context.Context) (*dto.UserResponse, error) {
+// RemoveCoupon is the resolver for the removeCoupon field.
+func (r *mutationResolver) RemoveCoupon(ctx context.Context) (*dto.CartResponse, error) {
 	userID, err := GetUserIDFromContext(ctx)
 	if err != nil {
-		return nil, err
+		return nil, ErrUnauthorized
 	}
 
-	user, err := r.userService.GetProfile(userID)
+	cart, err := r.cartService.RemoveCoupon(userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user profile: %w", err)
+		return nil, fmt.Errorf("failed to remove coupon: %w", err)
 	}
 
-	return user, nil
+	return cart, nil
 }
 
-// Products is the resolver for the products field.
-func (r *queryResolver) Products(ctx context.Context, page *int, limit *int) (*model.ProductConnection, error) {
-	p, l := getPagingNumbers(page, limit)
-	products, meta, err := r.productService.GetProducts(p, l)
+// ReserveCartStock is the resolver for the reserveCartStock field.
+func (r *mutationResolver) ReserveCartStock(ctx context.Context) (*dto.StockReservationResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get products: %w", err)
+		return nil, ErrUnauthorized
 	}
 
-	edges := make([]*model.ProductEdge, len(products)) // allocate enough memory for all the products
-	for i, product := range products {
-		edges[i] = &model.ProductEdge{
-			Node: &product,
-		}
+	reservation, err := r.cartService.ReserveStock(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve stock: %w", err)
 	}
 
-	return &model.ProductConnection{
-		Edges: edges,
-		PageInfo: &model.PageInfo{
-			Page:       meta.Page,
-			Limit:      meta.Limit,
-			Total:      int(meta.Total),
-			TotalPages: meta.TotalPages,
-		},
-	}, nil
+	return reservation, nil
 }
 
-// Product is the resolver for the product field.
-func (r *queryResolver) Product(ctx context.Context, id string) (*dto.ProductResponse, error) {
-	productID, err := r.parseID(id)
+// CreateOrder is the resolver for the createOrder field.
+func (r *mutationResolver) CreateOrder(ctx context.Context, input *dto.CreateOrderRequest) (*dto.OrderResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("invalid product ID: %w", err)
+		return nil, ErrUnauthorized
 	}
 
-	product, err := r.productService.GetProduct(productID)
+	if input == nil {
+		input = &dto.CreateOrderRequest{}
+	}
+
+	order, err := r.orderService.CreateOrder(userID, input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get product: %w", err)
+		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
-	return product, nil
+	return order, nil
 }
 
-// Categories is the resolver for the categories field.
-func (r *queryResolver) Categories(ctx context.Context) ([]*dto.CategoryResponse, error) {
-	categories, err := r.productService.GetCategories()
+// CancelOrder is the resolver for the cancelOrder field.
+func (r *mutationResolver) CancelOrder(ctx context.Context, id string, input dto.CancelOrderRequest) (*dto.OrderResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get categories: %w", err)
+		return nil, ErrUnauthorized
 	}
 
-	result := make([]*dto.CategoryResponse, len(categories))
-	for i, cat := range categories {
-		result[i] = &cat
+	orderID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order ID: %w", err)
 	}
 
-	return result, nil
+	order, err := r.orderService.CancelOrder(userID, orderID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel order: %w", err)
+	}
+
+	return order, nil
 }
 
-// Cart is the resolver for the cart field.
-func (r *queryResolver) Cart(ctx context.Context) (*dto.CartResponse, error) {
+// Reorder is the resolver for the reorder field.
+func (r *mutationResolver) Reorder(ctx context.Context, orderID string) (*dto.ReorderResponse, error) {
 	userID, err := GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, ErrUnauthorized
 	}
 
-	cart, err := r.cartService.GetCart(userID)
+	parsedOrderID, err := r.parseID(orderID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cart: %w", err)
+		return nil, fmt.Errorf("invalid order ID: %w", err)
 	}
 
-	return cart, nil
+	result, err := r.cartService.Reorder(userID, parsedOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reorder: %w", err)
+	}
+
+	return result, nil
 }
 
-// Orders is the resolver for the orders field.
-func (r *queryResolver) Orders(ctx context.Context, page *int, limit *int) (*model.OrderConnection, error) {
-	userID, err := GetUserIDFromContext(ctx)
+// UpdateOrderStatus is the resolver for the updateOrderStatus field.
+func (r *mutationResolver) UpdateOrderStatus(ctx context.Context, id string, input dto.UpdateOrderStatusRequest) (*dto.OrderResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	orderID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order ID: %w", err)
+	}
+
+	order, err := r.orderService.UpdateOrderStatus(orderID, &input)
 	if err != nil {
+		return nil, fmt.Errorf("failed to update order status: %w", err)
+	}
+
+	return order, nil
+}
+
+// CreateShipment is the resolver for the createShipment field.
+func (r *mutationResolver) CreateShipment(ctx context.Context, orderID string, input dto.CreateShipmentRequest) (*dto.OrderResponse, error) {
+	if !IsAdminFromContext(ctx) {
 		return nil, ErrUnauthorized
 	}
 
-	p, l := getPagingNumbers(page, limit)
+	parsedOrderID, err := r.parseID(orderID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order ID: %w", err)
+	}
 
-	orders, meta, err := r.orderService.GetOrders(userID, p, l)
+	order, err := r.orderService.CreateShipment(parsedOrderID, &input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get orders: %w", err)
+		return nil, fmt.Errorf("failed to create shipment: %w", err)
 	}
 
-	edges := make([]*model.OrderEdge, len(orders))
-	for i, order := range orders {
-		edges[i] = &model.OrderEdge{
-			Node: &order,
-		}
+	return order, nil
+}
+
+// MarkShipmentDelivered is the resolver for the markShipmentDelivered field.
+func (r *mutationResolver) MarkShipmentDelivered(ctx context.Context, id string) (*dto.OrderResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
 	}
 
-	return &model.OrderConnection{
-		Edges: edges,
-		PageInfo: &model.PageInfo{
-			Page:       meta.Page,
-			Limit:      meta.Limit,
-			Total:      int(meta.Total),
-			TotalPages: meta.TotalPages,
-		},
-	}, nil
+	shipmentID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shipment ID: %w", err)
+	}
+
+	order, err := r.orderService.MarkShipmentDelivered(shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark shipment delivered: %w", err)
+	}
+
+	return order, nil
 }
 
-// Order is the resolver for the order field.
-func (r *queryResolver) Order(ctx context.Context, id string) (*dto.OrderResponse, error) {
+// RequestReturn is the resolver for the requestReturn field.
+func (r *mutationResolver) RequestReturn(ctx context.Context, orderID string, input dto.CreateReturnRequest) (*dto.ReturnResponse, error) {
 	userID, err := GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, ErrUnauthorized
 	}
 
-	orderID, err := r.parseID(id)
+	parsedOrderID, err := r.parseID(orderID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid order ID: %w", err)
 	}
 
-	order, err := r.orderService.GetOrder(userID, orderID)
+	returnRequest, err := r.returnService.RequestReturn(userID, parsedOrderID, &input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get order: %w", err)
+		return nil, fmt.Errorf("failed to request return: %w", err)
 	}
 
-	return order, nil
+	return returnRequest, nil
+}
+
+// ApproveReturn is the resolver for the approveReturn field.
+func (r *mutationResolver) ApproveReturn(ctx context.Context, id string) (*dto.ReturnResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	returnID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid return ID: %w", err)
+	}
+
+	returnRequest, err := r.returnService.ApproveReturn(returnID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve return: %w", err)
+	}
+
+	return returnRequest, nil
+}
+
+// RejectReturn is the resolver for the rejectReturn field.
+func (r *mutationResolver) RejectReturn(ctx context.Context, id string, input dto.RejectReturnRequest) (*dto.ReturnResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	returnID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid return ID: %w", err)
+	}
+
+	returnRequest, err := r.returnService.RejectReturn(returnID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reject return: %w", err)
+	}
+
+	return returnRequest, nil
+}
+
+// ReceiveReturn is the resolver for the receiveReturn field.
+func (r *mutationResolver) ReceiveReturn(ctx context.Context, id string) (*dto.ReturnResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	returnID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid return ID: %w", err)
+	}
+
+	returnRequest, err := r.returnService.ReceiveReturn(returnID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive return: %w", err)
+	}
+
+	return returnRequest, nil
+}
+
+// RefundReturn is the resolver for the refundReturn field.
+func (r *mutationResolver) RefundReturn(ctx context.Context, id string, input *dto.RefundReturnRequest) (*dto.ReturnResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	returnID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid return ID: %w", err)
+	}
+
+	if input == nil {
+		input = &dto.RefundReturnRequest{}
+	}
+
+	returnRequest, err := r.returnService.RefundReturn(returnID, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refund return: %w", err)
+	}
+
+	return returnRequest, nil
+}
+
+// CreateWishlist is the resolver for the createWishlist field.
+func (r *mutationResolver) CreateWishlist(ctx context.Context, input dto.CreateWishlistRequest) (*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlist, err := r.wishlistService.CreateWishlist(userID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// UpdateWishlist is the resolver for the updateWishlist field.
+func (r *mutationResolver) UpdateWishlist(ctx context.Context, id string, input dto.UpdateWishlistRequest) (*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	wishlist, err := r.wishlistService.UpdateWishlist(userID, wishlistID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// DeleteWishlist is the resolver for the deleteWishlist field.
+func (r *mutationResolver) DeleteWishlist(ctx context.Context, id string) (bool, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return false, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	err = r.wishlistService.DeleteWishlist(userID, wishlistID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete wishlist: %w", err)
+	}
+
+	return true, nil
+}
+
+// AddToWishlist is the resolver for the addToWishlist field.
+func (r *mutationResolver) AddToWishlist(ctx context.Context, id string, input dto.AddWishlistItemRequest) (*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	wishlist, err := r.wishlistService.AddItem(userID, wishlistID, &input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add to wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// RemoveFromWishlist is the resolver for the removeFromWishlist field.
+func (r *mutationResolver) RemoveFromWishlist(ctx context.Context, id string, itemID string) (*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	parsedItemID, err := r.parseID(itemID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	wishlist, err := r.wishlistService.RemoveItem(userID, wishlistID, parsedItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove from wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// MoveWishlistItemToCart is the resolver for the moveWishlistItemToCart field.
+func (r *mutationResolver) MoveWishlistItemToCart(ctx context.Context, id string, itemID string, input *dto.MoveWishlistItemRequest) (*dto.CartResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	parsedItemID, err := r.parseID(itemID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item ID: %w", err)
+	}
+
+	if input == nil {
+		input = &dto.MoveWishlistItemRequest{}
+	}
+
+	cart, err := r.wishlistService.MoveToCart(userID, wishlistID, parsedItemID, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move wishlist item to cart: %w", err)
+	}
+
+	return cart, nil
+}
+
+// ShareWishlist is the resolver for the shareWishlist field.
+func (r *mutationResolver) ShareWishlist(ctx context.Context, id string) (*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	wishlist, err := r.wishlistService.ShareWishlist(userID, wishlistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to share wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// UnshareWishlist is the resolver for the unshareWishlist field.
+func (r *mutationResolver) UnshareWishlist(ctx context.Context, id string) (*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	wishlist, err := r.wishlistService.UnshareWishlist(userID, wishlistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unshare wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*dto.UserResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.userService.GetProfile(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+
+	return user, nil
+}
+
+// Addresses is the resolver for the addresses field.
+func (r *queryResolver) Addresses(ctx context.Context) ([]*dto.AddressResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	addresses, err := r.addressService.GetAddresses(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addresses: %w", err)
+	}
+
+	result := make([]*dto.AddressResponse, len(addresses))
+	for i := range addresses {
+		result[i] = &addresses[i]
+	}
+
+	return result, nil
+}
+
+// Products is the resolver for the products field.
+func (r *queryResolver) Products(ctx context.Context, filter *dto.ProductFilter, page *int, limit *int) (*model.ProductConnection, error) {
+	p, l := getPagingNumbers(page, limit)
+	products, meta, err := r.productService.GetProducts(filter, p, l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	edges := make([]*model.ProductEdge, len(products)) // allocate enough memory for all the products
+	for i, product := range products {
+		edges[i] = &model.ProductEdge{
+			Node: &product,
+		}
+	}
+
+	return &model.ProductConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			Page:       meta.Page,
+			Limit:      meta.Limit,
+			Total:      int(meta.Total),
+			TotalPages: meta.TotalPages,
+		},
+	}, nil
+}
+
+// Product is the resolver for the product field.
+func (r *queryResolver) Product(ctx context.Context, id string) (*dto.ProductResponse, error) {
+	productID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product ID: %w", err)
+	}
+
+	product, err := r.productService.GetProduct(productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return product, nil
+}
+
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, input dto.SearchProductsRequest, page *int, limit *int) (*model.SearchConnection, error) {
+	input.Page, input.Limit = getPagingNumbers(page, limit)
+
+	results, facets, meta, err := r.productService.SearchProducts(&input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search products: %w", err)
+	}
+
+	edges := make([]*model.SearchEdge, len(results))
+	for i := range results {
+		edges[i] = &model.SearchEdge{
+			Node: &results[i].ProductResponse,
+			Rank: float64(results[i].Rank),
+		}
+	}
+
+	return &model.SearchConnection{
+		Edges:  edges,
+		Facets: facets,
+		PageInfo: &model.PageInfo{
+			Page:       meta.Page,
+			Limit:      meta.Limit,
+			Total:      int(meta.Total),
+			TotalPages: meta.TotalPages,
+		},
+	}, nil
+}
+
+// SearchSuggestions is the resolver for the searchSuggestions field.
+func (r *queryResolver) SearchSuggestions(ctx context.Context, query string, limit *int) (*dto.SearchSuggestionsResponse, error) {
+	req := dto.SearchSuggestRequest{Query: query}
+	if limit != nil {
+		req.Limit = *limit
+	}
+
+	suggestions, err := r.productService.SuggestSearch(&req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest searches: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+// Categories is the resolver for the categories field.
+func (r *queryResolver) Categories(ctx context.Context) ([]*dto.CategoryResponse, error) {
+	categories, err := r.productService.GetCategories()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	result := make([]*dto.CategoryResponse, len(categories))
+	for i, cat := range categories {
+		result[i] = &cat
+	}
+
+	return result, nil
+}
+
+// CategoryTree is the resolver for the categoryTree field.
+func (r *queryResolver) CategoryTree(ctx context.Context) ([]*dto.CategoryResponse, error) {
+	tree, err := r.productService.GetCategoryTree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category tree: %w", err)
+	}
+
+	// Children are resolved from the category itself
+	result := make([]*dto.CategoryResponse, len(tree))
+	for i := range tree {
+		result[i] = &tree[i].CategoryResponse
+	}
+
+	return result, nil
+}
+
+// Cart is the resolver for the cart field.
+func (r *queryResolver) Cart(ctx context.Context) (*dto.CartResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	cart, err := r.cartService.GetCart(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cart: %w", err)
+	}
+
+	return cart, nil
+}
+
+// ShippingOptions is the resolver for the shippingOptions field.
+func (r *queryResolver) ShippingOptions(ctx context.Context, addressID *uint, country *string, region *string) ([]*dto.ShippingOptionResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	req := dto.ShippingOptionsRequest{AddressID: addressID}
+	if country != nil {
+		req.Country = *country
+	}
+	if region != nil {
+		req.Region = *region
+	}
+
+	options, err := r.shippingService.GetShippingOptions(userID, &req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipping options: %w", err)
+	}
+
+	result := make([]*dto.ShippingOptionResponse, len(options))
+	for i := range options {
+		result[i] = &options[i]
+	}
+
+	return result, nil
+}
+
+// Orders is the resolver for the orders field.
+func (r *queryResolver) Orders(ctx context.Context, page *int, limit *int) (*model.OrderConnection, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	p, l := getPagingNumbers(page, limit)
+
+	orders, meta, err := r.orderService.GetOrders(userID, p, l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	edges := make([]*model.OrderEdge, len(orders))
+	for i, order := range orders {
+		edges[i] = &model.OrderEdge{
+			Node: &order,
+		}
+	}
+
+	return &model.OrderConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			Page:       meta.Page,
+			Limit:      meta.Limit,
+			Total:      int(meta.Total),
+			TotalPages: meta.TotalPages,
+		},
+	}, nil
+}
+
+// Order is the resolver for the order field.
+func (r *queryResolver) Order(ctx context.Context, id string) (*dto.OrderResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	orderID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order ID: %w", err)
+	}
+
+	order, err := r.orderService.GetOrder(userID, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return order, nil
+}
+
+// AdminOrders is the resolver for the adminOrders field.
+func (r *queryResolver) AdminOrders(ctx context.Context, filter *dto.AdminOrderFilter, page *int, limit *int) (*model.OrderConnection, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	p, l := getPagingNumbers(page, limit)
+
+	orders, meta, err := r.orderService.GetAllOrders(filter, p, l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	edges := make([]*model.OrderEdge, len(orders))
+	for i := range orders {
+		edges[i] = &model.OrderEdge{
+			Node: &orders[i],
+		}
+	}
+
+	return &model.OrderConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			Page:       meta.Page,
+			Limit:      meta.Limit,
+			Total:      int(meta.Total),
+			TotalPages: meta.TotalPages,
+		},
+	}, nil
+}
+
+// AdminOrder is the resolver for the adminOrder field.
+func (r *queryResolver) AdminOrder(ctx context.Context, id string) (*dto.OrderResponse, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	orderID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order ID: %w", err)
+	}
+
+	order, err := r.orderService.GetOrderByID(orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return order, nil
+}
+
+// Returns is the resolver for the returns field.
+func (r *queryResolver) Returns(ctx context.Context, page *int, limit *int) (*model.ReturnConnection, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	p, l := getPagingNumbers(page, limit)
+
+	returns, meta, err := r.returnService.GetReturns(userID, p, l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get returns: %w", err)
+	}
+
+	edges := make([]*model.ReturnEdge, len(returns))
+	for i := range returns {
+		edges[i] = &model.ReturnEdge{
+			Node: &returns[i],
+		}
+	}
+
+	return &model.ReturnConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			Page:       meta.Page,
+			Limit:      meta.Limit,
+			Total:      int(meta.Total),
+			TotalPages: meta.TotalPages,
+		},
+	}, nil
+}
+
+// Return is the resolver for the return field.
+func (r *queryResolver) Return(ctx context.Context, id string) (*dto.ReturnResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	returnID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid return ID: %w", err)
+	}
+
+	returnRequest, err := r.returnService.GetReturn(userID, returnID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get return: %w", err)
+	}
+
+	return returnRequest, nil
+}
+
+// AdminReturns is the resolver for the adminReturns field.
+func (r *queryResolver) AdminReturns(ctx context.Context, status *string, page *int, limit *int) (*model.ReturnConnection, error) {
+	if !IsAdminFromContext(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	p, l := getPagingNumbers(page, limit)
+
+	var returnStatus string
+	if status != nil {
+		returnStatus = *status
+	}
+
+	returns, meta, err := r.returnService.GetAllReturns(returnStatus, p, l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get returns: %w", err)
+	}
+
+	edges := make([]*model.ReturnEdge, len(returns))
+	for i := range returns {
+		edges[i] = &model.ReturnEdge{
+			Node: &returns[i],
+		}
+	}
+
+	return &model.ReturnConnection{
+		Edges: edges,
+		PageInfo: &model.PageInfo{
+			Page:       meta.Page,
+			Limit:      meta.Limit,
+			Total:      int(meta.Total),
+			TotalPages: meta.TotalPages,
+		},
+	}, nil
+}
+
+// Wishlists is the resolver for the wishlists field.
+func (r *queryResolver) Wishlists(ctx context.Context) ([]*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlists, err := r.wishlistService.GetWishlists(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlists: %w", err)
+	}
+
+	result := make([]*dto.WishlistResponse, len(wishlists))
+	for i := range wishlists {
+		result[i] = &wishlists[i]
+	}
+
+	return result, nil
+}
+
+// Wishlist is the resolver for the wishlist field.
+func (r *queryResolver) Wishlist(ctx context.Context, id string) (*dto.WishlistResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	wishlistID, err := r.parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wishlist ID: %w", err)
+	}
+
+	wishlist, err := r.wishlistService.GetWishlist(userID, wishlistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// SharedWishlist is the resolver for the sharedWishlist field.
+func (r *queryResolver) SharedWishlist(ctx context.Context, token string) (*dto.WishlistResponse, error) {
+	wishlist, err := r.wishlistService.GetSharedWishlist(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared wishlist: %w", err)
+	}
+
+	return wishlist, nil
 }
 
 // Mutation returns graph.MutationResolver implementation.
@@ -8,20 +8,29 @@ package resolver
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/vijayaragavanmg/learning-go-shop/graph"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
 )
 
+// ID is the resolver for the id field.
+func (r *addressResolver) ID(ctx context.Context, obj *dto.AddressResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *cartResolver) ID(ctx context.Context, obj *dto.CartResponse) (string, error) {
 	return fmt.Sprintf("%d", obj.ID), nil
 }
 
 // UserID is the resolver for the user_id field.
-func (r *cartResolver) UserID(ctx context.Context, obj *dto.CartResponse) (string, error) {
-	return fmt.Sprintf("%d", obj.UserID), nil
+func (r *cartResolver) UserID(ctx context.Context, obj *dto.CartResponse) (*string, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+
+	id := fmt.Sprintf("%d", *obj.UserID)
+	return &id, nil
 }
 
 // ID is the resolver for the id field.
@@ -29,24 +38,99 @@ func (r *cartItemResolver) ID(ctx context.Context, obj *dto.CartItemResponse) (s
 	return fmt.Sprintf("%d", obj.ID), nil
 }
 
+// ProductID is the resolver for the product_id field.
+func (r *cartMergeAdjustmentResolver) ProductID(ctx context.Context, obj *dto.CartMergeAdjustmentResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ProductID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *categoryResolver) ID(ctx context.Context, obj *dto.CategoryResponse) (string, error) {
 	return fmt.Sprintf("%d", obj.ID), nil
 }
 
+// ParentID is the resolver for the parent_id field.
+func (r *categoryResolver) ParentID(ctx context.Context, obj *dto.CategoryResponse) (*string, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+
+	id := fmt.Sprintf("%d", *obj.ParentID)
+	return &id, nil
+}
+
+// Children is the resolver for the children field.
+func (r *categoryResolver) Children(ctx context.Context, obj *dto.CategoryResponse) ([]*dto.CategoryResponse, error) {
+	children, err := r.productService.GetChildCategories(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child categories: %w", err)
+	}
+
+	result := make([]*dto.CategoryResponse, len(children))
+	for i := range children {
+		result[i] = &children[i]
+	}
+
+	return result, nil
+}
+
+// Breadcrumbs is the resolver for the breadcrumbs field.
+func (r *categoryResolver) Breadcrumbs(ctx context.Context, obj *dto.CategoryResponse) ([]*dto.CategoryBreadcrumbResponse, error) {
+	breadcrumbs, err := r.productService.GetCategoryBreadcrumbs(obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category breadcrumbs: %w", err)
+	}
+
+	result := make([]*dto.CategoryBreadcrumbResponse, len(breadcrumbs))
+	for i := range breadcrumbs {
+		result[i] = &breadcrumbs[i]
+	}
+
+	return result, nil
+}
+
+// ID is the resolver for the id field.
+func (r *categoryBreadcrumbResolver) ID(ctx context.Context, obj *dto.CategoryBreadcrumbResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// CategoryID is the resolver for the category_id field.
+func (r *categoryFacetResolver) CategoryID(ctx context.Context, obj *dto.CategoryFacetResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.CategoryID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *categorySuggestionResolver) ID(ctx context.Context, obj *dto.CategorySuggestionResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *orderResolver) ID(ctx context.Context, obj *dto.OrderResponse) (string, error) {
 	return fmt.Sprintf("%d", obj.ID), nil
 }
 
 // UserID is the resolver for the user_id field.
-func (r *orderResolver) UserID(ctx context.Context, obj *dto.OrderResponse) (string, error) {
-	return fmt.Sprintf("%d", obj.UserID), nil
+func (r *orderResolver) UserID(ctx context.Context, obj *dto.OrderResponse) (*string, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+
+	id := fmt.Sprintf("%d", *obj.UserID)
+	return &id, nil
 }
 
-// CreatedAt is the resolver for the created_at field.
-func (r *orderResolver) CreatedAt(ctx context.Context, obj *dto.OrderResponse) (*time.Time, error) {
-	panic(fmt.Errorf("not implemented: CreatedAt - created_at"))
+// ShippingMethodID is the resolver for the shipping_method_id field.
+func (r *orderResolver) ShippingMethodID(ctx context.Context, obj *dto.OrderResponse) (*string, error) {
+	if obj.ShippingMethodID == nil {
+		return nil, nil
+	}
+
+	id := fmt.Sprintf("%d", *obj.ShippingMethodID)
+	return &id, nil
+}
+
+// ID is the resolver for the id field.
+func (r *orderCustomerResolver) ID(ctx context.Context, obj *dto.OrderCustomerResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
 }
 
 // ID is the resolver for the id field.
@@ -54,6 +138,26 @@ func (r *orderItemResolver) ID(ctx context.Context, obj *dto.OrderItemResponse)
 	return fmt.Sprintf("%d", obj.ID), nil
 }
 
+// ProductID is the resolver for the product_id field.
+func (r *orderItemResolver) ProductID(ctx context.Context, obj *dto.OrderItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ProductID), nil
+}
+
+// VariantID is the resolver for the variant_id field.
+func (r *orderItemResolver) VariantID(ctx context.Context, obj *dto.OrderItemResponse) (*string, error) {
+	if obj.VariantID == nil {
+		return nil, nil
+	}
+
+	id := fmt.Sprintf("%d", *obj.VariantID)
+	return &id, nil
+}
+
+// ID is the resolver for the id field.
+func (r *paymentResolver) ID(ctx context.Context, obj *dto.PaymentResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *productResolver) ID(ctx context.Context, obj *dto.ProductResponse) (string, error) {
 	return fmt.Sprintf("%d", obj.ID), nil
@@ -61,7 +165,7 @@ func (r *productResolver) ID(ctx context.Context, obj *dto.ProductResponse) (str
 
 // CategoryID is the resolver for the category_id field.
 func (r *productResolver) CategoryID(ctx context.Context, obj *dto.ProductResponse) (string, error) {
-	panic(fmt.Errorf("not implemented: CategoryID - category_id"))
+	return fmt.Sprintf("%d", obj.CategoryID), nil
 }
 
 // ID is the resolver for the id field.
@@ -69,40 +173,238 @@ func (r *productImageResolver) ID(ctx context.Context, obj *dto.ProductImageResp
 	return fmt.Sprintf("%d", obj.ID), nil
 }
 
+// VariantID is the resolver for the variant_id field.
+func (r *productImageResolver) VariantID(ctx context.Context, obj *dto.ProductImageResponse) (*string, error) {
+	if obj.VariantID == nil {
+		return nil, nil
+	}
+
+	id := fmt.Sprintf("%d", *obj.VariantID)
+	return &id, nil
+}
+
+// ID is the resolver for the id field.
+func (r *productOptionResolver) ID(ctx context.Context, obj *dto.ProductOptionResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *productSuggestionResolver) ID(ctx context.Context, obj *dto.ProductSuggestionResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *productVariantResolver) ID(ctx context.Context, obj *dto.ProductVariantResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// OrderItemID is the resolver for the order_item_id field.
+func (r *reorderAdjustmentResolver) OrderItemID(ctx context.Context, obj *dto.ReorderAdjustmentResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.OrderItemID), nil
+}
+
+// ProductID is the resolver for the product_id field.
+func (r *reorderAdjustmentResolver) ProductID(ctx context.Context, obj *dto.ReorderAdjustmentResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ProductID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *returnResolver) ID(ctx context.Context, obj *dto.ReturnResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// OrderID is the resolver for the order_id field.
+func (r *returnResolver) OrderID(ctx context.Context, obj *dto.ReturnResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.OrderID), nil
+}
+
+// UserID is the resolver for the user_id field.
+func (r *returnResolver) UserID(ctx context.Context, obj *dto.ReturnResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.UserID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *returnItemResolver) ID(ctx context.Context, obj *dto.ReturnItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// OrderItemID is the resolver for the order_item_id field.
+func (r *returnItemResolver) OrderItemID(ctx context.Context, obj *dto.ReturnItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.OrderItemID), nil
+}
+
+// ProductID is the resolver for the product_id field.
+func (r *returnItemResolver) ProductID(ctx context.Context, obj *dto.ReturnItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ProductID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *shipmentResolver) ID(ctx context.Context, obj *dto.ShipmentResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *shipmentItemResolver) ID(ctx context.Context, obj *dto.ShipmentItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// OrderItemID is the resolver for the order_item_id field.
+func (r *shipmentItemResolver) OrderItemID(ctx context.Context, obj *dto.ShipmentItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.OrderItemID), nil
+}
+
+// MethodID is the resolver for the method_id field.
+func (r *shippingOptionResolver) MethodID(ctx context.Context, obj *dto.ShippingOptionResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.MethodID), nil
+}
+
+// ProductID is the resolver for the product_id field.
+func (r *stockReservationItemResolver) ProductID(ctx context.Context, obj *dto.StockReservationItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ProductID), nil
+}
+
+// VariantID is the resolver for the variant_id field.
+func (r *stockReservationItemResolver) VariantID(ctx context.Context, obj *dto.StockReservationItemResponse) (*string, error) {
+	if obj.VariantID == nil {
+		return nil, nil
+	}
+
+	id := fmt.Sprintf("%d", *obj.VariantID)
+	return &id, nil
+}
+
 // ID is the resolver for the id field.
 func (r *userResolver) ID(ctx context.Context, obj *dto.UserResponse) (string, error) {
 	return fmt.Sprintf("%d", obj.ID), nil
 }
 
+// ID is the resolver for the id field.
+func (r *wishlistResolver) ID(ctx context.Context, obj *dto.WishlistResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *wishlistItemResolver) ID(ctx context.Context, obj *dto.WishlistItemResponse) (string, error) {
+	return fmt.Sprintf("%d", obj.ID), nil
+}
+
+// Address returns graph.AddressResolver implementation.
+func (r *Resolver) Address() graph.AddressResolver { return &addressResolver{r} }
+
 // Cart returns graph.CartResolver implementation.
 func (r *Resolver) Cart() graph.CartResolver { return &cartResolver{r} }
 
 // CartItem returns graph.CartItemResolver implementation.
 func (r *Resolver) CartItem() graph.CartItemResolver { return &cartItemResolver{r} }
 
+// CartMergeAdjustment returns graph.CartMergeAdjustmentResolver implementation.
+func (r *Resolver) CartMergeAdjustment() graph.CartMergeAdjustmentResolver {
+	return &cartMergeAdjustmentResolver{r}
+}
+
 // Category returns graph.CategoryResolver implementation.
 func (r *Resolver) Category() graph.CategoryResolver { return &categoryResolver{r} }
 
+// CategoryBreadcrumb returns graph.CategoryBreadcrumbResolver implementation.
+func (r *Resolver) CategoryBreadcrumb() graph.CategoryBreadcrumbResolver {
+	return &categoryBreadcrumbResolver{r}
+}
+
+// CategoryFacet returns graph.CategoryFacetResolver implementation.
+func (r *Resolver) CategoryFacet() graph.CategoryFacetResolver { return &categoryFacetResolver{r} }
+
+// CategorySuggestion returns graph.CategorySuggestionResolver implementation.
+func (r *Resolver) CategorySuggestion() graph.CategorySuggestionResolver {
+	return &categorySuggestionResolver{r}
+}
+
 // Order returns graph.OrderResolver implementation.
 func (r *Resolver) Order() graph.OrderResolver { return &orderResolver{r} }
 
+// OrderCustomer returns graph.OrderCustomerResolver implementation.
+func (r *Resolver) OrderCustomer() graph.OrderCustomerResolver { return &orderCustomerResolver{r} }
+
 // OrderItem returns graph.OrderItemResolver implementation.
 func (r *Resolver) OrderItem() graph.OrderItemResolver { return &orderItemResolver{r} }
 
+// Payment returns graph.PaymentResolver implementation.
+func (r *Resolver) Payment() graph.PaymentResolver { return &paymentResolver{r} }
+
 // Product returns graph.ProductResolver implementation.
 func (r *Resolver) Product() graph.ProductResolver { return &productResolver{r} }
 
 // ProductImage returns graph.ProductImageResolver implementation.
 func (r *Resolver) ProductImage() graph.ProductImageResolver { return &productImageResolver{r} }
 
+// ProductOption returns graph.ProductOptionResolver implementation.
+func (r *Resolver) ProductOption() graph.ProductOptionResolver { return &productOptionResolver{r} }
+
+// ProductSuggestion returns graph.ProductSuggestionResolver implementation.
+func (r *Resolver) ProductSuggestion() graph.ProductSuggestionResolver {
+	return &productSuggestionResolver{r}
+}
+
+// ProductVariant returns graph.ProductVariantResolver implementation.
+func (r *Resolver) ProductVariant() graph.ProductVariantResolver { return &productVariantResolver{r} }
+
+// ReorderAdjustment returns graph.ReorderAdjustmentResolver implementation.
+func (r *Resolver) ReorderAdjustment() graph.ReorderAdjustmentResolver {
+	return &reorderAdjustmentResolver{r}
+}
+
+// Return returns graph.ReturnResolver implementation.
+func (r *Resolver) Return() graph.ReturnResolver { return &returnResolver{r} }
+
+// ReturnItem returns graph.ReturnItemResolver implementation.
+func (r *Resolver) ReturnItem() graph.ReturnItemResolver { return &returnItemResolver{r} }
+
+// Shipment returns graph.ShipmentResolver implementation.
+func (r *Resolver) Shipment() graph.ShipmentResolver { return &shipmentResolver{r} }
+
+// ShipmentItem returns graph.ShipmentItemResolver implementation.
+func (r *Resolver) ShipmentItem() graph.ShipmentItemResolver { return &shipmentItemResolver{r} }
+
+// ShippingOption returns graph.ShippingOptionResolver implementation.
+func (r *Resolver) ShippingOption() graph.ShippingOptionResolver { return &shippingOptionResolver{r} }
+
+// StockReservationItem returns graph.StockReservationItemResolver implementation.
+func (r *Resolver) StockReservationItem() graph.StockReservationItemResolver {
+	return &stockReservationItemResolver{r}
+}
+
 // User returns graph.UserResolver implementation.
 func (r *Resolver) User() graph.UserResolver { return &userResolver{r} }
 
+// Wishlist returns graph.WishlistResolver implementation.
+func (r *Resolver) Wishlist() graph.WishlistResolver { return &wishlistResolver{r} }
+
+// WishlistItem returns graph.WishlistItemResolver implementation.
+func (r *Resolver) WishlistItem() graph.WishlistItemResolver { return &wishlistItemResolver{r} }
+
+type addressResolver struct{ *Resolver }
 type cartResolver struct{ *Resolver }
 type cartItemResolver struct{ *Resolver }
+type cartMergeAdjustmentResolver struct{ *Resolver }
 type categoryResolver struct{ *Resolver }
+type categoryBreadcrumbResolver struct{ *Resolver }
+type categoryFacetResolver struct{ *Resolver }
+type categorySuggestionResolver struct{ *Resolver }
 type orderResolver struct{ *Resolver }
+type orderCustomerResolver struct{ *Resolver }
 type orderItemResolver struct{ *Resolver }
+type paymentResolver struct{ *Resolver }
 type productResolver struct{ *Resolver }
 type productImageResolver struct{ *Resolver }
+type productOptionResolver struct{ *Resolver }
+type productSuggestionResolver struct{ *Resolver }
+type productVariantResolver struct{ *Resolver }
+type reorderAdjustmentResolver struct{ *Resolver }
+type returnResolver struct{ *Resolver }
+type returnItemResolver struct{ *Resolver }
+type shipmentResolver struct{ *Resolver }
+type shipmentItemResolver struct{ *Resolver }
+type shippingOptionResolver struct{ *Resolver }
+type stockReservationItemResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
+type wishlistResolver struct{ *Resolver }
+type wishlistItemResolver struct{ *Resolver }
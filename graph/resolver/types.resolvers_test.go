@@ -0,0 +1,42 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vijayaragavanmg/learning-go-shop/graph"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/services"
+)
+
+// stubProductService serves one product. Calling any other method panics.
+type stubProductService struct {
+	services.ProductServiceInterface
+	product dto.ProductResponse
+}
+
+func (s *stubProductService) GetProduct(id uint) (*dto.ProductResponse, error) {
+	return &s.product, nil
+}
+
+func TestProductCategoryID(t *testing.T) {
+	productService := &stubProductService{product: dto.ProductResponse{ID: 7, CategoryID: 42}}
+	rvr := NewResolver(nil, nil, productService, nil, nil, nil, nil, nil, nil)
+
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: rvr}))
+	srv.AddTransport(transport.POST{})
+
+	var resp struct {
+		Product struct {
+			ID         string `json:"id"`
+			CategoryID string `json:"category_id"`
+		}
+	}
+	client.New(srv).MustPost(`{ product(id: "7") { id category_id } }`, &resp)
+
+	if resp.Product.CategoryID != "42" {
+		t.Errorf("category_id is %q, want %q", resp.Product.CategoryID, "42")
+	}
+}
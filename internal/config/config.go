@@ -17,6 +17,10 @@ type Config struct {
 	AWS      AWSConfig
 	Upload   UploadConfig
 	SMTP     SMTPConfig
+	Payment  PaymentConfig
+	Tax      TaxConfig
+	Invoice  InvoiceConfig
+	Checkout CheckoutConfig
 }
 
 // ServerConfig contains HTTP server settings such as port and GinMode.
@@ -48,6 +52,9 @@ type JWTConfig struct {
 
 	// RefreshTokenExpires is the refresh token time-to-live (TTL).
 	RefreshTokenExpires time.Duration
+
+	// CartTokenExpires is the time-to-live (TTL) of the tokens that identify guest carts.
+	CartTokenExpires time.Duration
 }
 
 // AWSConfig contains AWS-related settings used by the application, including
@@ -67,6 +74,10 @@ type AWSConfig struct {
 	// S3Bucket is the S3 bucket name used by the application.
 	S3Bucket string
 
+	// S3PrivateBucket holds the files that must not be public, such as
+	// invoices. They are only served through authenticated handlers.
+	S3PrivateBucket string
+
 	// S3Endpoint is an optional custom endpoint (useful for S3-compatible storage or local testing).
 	// Leave empty to use AWS default endpoints.
 	S3Endpoint string
@@ -83,12 +94,55 @@ type SMTPConfig struct {
 	From     string
 }
 
+// PaymentConfig contains payment provider selection and webhook settings.
+type PaymentConfig struct {
+	// Provider selects the payment gateway. Only fake is available for now.
+	Provider string
+
+	// WebhookSecret is the key used to verify the signature of provider webhooks.
+	WebhookSecret string
+}
+
+// TaxConfig contains settings for tax calculation. Rates themselves are kept
+// in the database per country, region and tax class.
+type TaxConfig struct {
+	// PricesIncludeTax is true when catalog prices already contain tax, so tax is
+	// extracted from them rather than added on top.
+	PricesIncludeTax bool
+}
+
+// InvoiceConfig contains the seller details printed on invoices.
+type InvoiceConfig struct {
+	SellerName string
+
+	// SellerAddress is printed one comma separated part per line.
+	SellerAddress string
+
+	SellerEmail string
+
+	// SellerTaxID is the seller's VAT or sales tax registration number.
+	SellerTaxID string
+}
+
+// CheckoutConfig contains settings for holding stock while carts are checked out.
+type CheckoutConfig struct {
+	// ReservationTTL is how long stock reserved for a cart is held.
+	ReservationTTL time.Duration
+
+	// ReservationSweepInterval is how often expired reservations are deleted.
+	ReservationSweepInterval time.Duration
+}
+
 // UploadConfig contains settings for file uploads, including storage location,
 // provider selection, and size limits.
 type UploadConfig struct {
 	Path        string
 	MaxFileSize int64
 
+	// PrivatePath is where the files that must not be public, such as
+	// invoices, are stored by the local provider. Unlike Path it isn't served.
+	PrivatePath string
+
 	// UploadProvider  can be s3 or local
 	UploadProvider string
 }
@@ -100,8 +154,12 @@ func Load() (*Config, error) {
 
 	jwtExpiresIn, _ := time.ParseDuration(getEnv("JWT_EXPIRES_IN", "24h"))
 	refreshTokenExpires, _ := time.ParseDuration(getEnv("REFRESH_TOKEN_EXPIRES_IN", "720h"))
+	cartTokenExpires, _ := time.ParseDuration(getEnv("CART_TOKEN_EXPIRES_IN", "720h"))
+	reservationTTL, _ := time.ParseDuration(getEnv("STOCK_RESERVATION_TTL", "15m"))
+	reservationSweepInterval, _ := time.ParseDuration(getEnv("STOCK_RESERVATION_SWEEP_INTERVAL", "1m"))
 	maxUploadSize, _ := strconv.ParseInt(getEnv("MAX_UPLOAD_SIZE", "10485760"), 10, 64)
 	smtpPort, _ := strconv.Atoi(getEnv("SMTP_PORT", "1025"))
+	pricesIncludeTax, _ := strconv.ParseBool(getEnv("TAX_PRICES_INCLUDE_TAX", "false"))
 
 	return &Config{
 		Server: ServerConfig{
@@ -120,17 +178,20 @@ func Load() (*Config, error) {
 			Secret:              getEnv("JWT_SECRET", "your-super-secret-jwt-key"),
 			ExpiresIn:           jwtExpiresIn,
 			RefreshTokenExpires: refreshTokenExpires,
+			CartTokenExpires:    cartTokenExpires,
 		},
 		AWS: AWSConfig{
 			Region:          getEnv("AWS_REGION", "us-east-1"),
 			AccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", "test"),
 			SecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", "test"),
 			S3Bucket:        getEnv("AWS_S3_BUCKET", "ecommerce-uploads"),
+			S3PrivateBucket: getEnv("AWS_S3_PRIVATE_BUCKET", "ecommerce-private"),
 			S3Endpoint:      getEnv("AWS_S3_ENDPOINT", "http://localhost:4566"),
 			EventQueueName:  getEnv("AWS_EVENT_QUEUE_NAME", "ecommerce-events"),
 		},
 		Upload: UploadConfig{
 			Path:           getEnv("UPLOAD_PATH", "./uploads"),
+			PrivatePath:    getEnv("PRIVATE_UPLOAD_PATH", "./private"),
 			MaxFileSize:    maxUploadSize,
 			UploadProvider: getEnv("UPLOAD_PROVIDER", "local"),
 		},
@@ -141,6 +202,23 @@ func Load() (*Config, error) {
 			Password: getEnv("SMTP_PASSWORD", ""),
 			From:     getEnv("SMTP_FROM", "noreply@shop.com"),
 		},
+		Payment: PaymentConfig{
+			Provider:      getEnv("PAYMENT_PROVIDER", "fake"),
+			WebhookSecret: getEnv("PAYMENT_WEBHOOK_SECRET", "your-payment-webhook-secret"),
+		},
+		Tax: TaxConfig{
+			PricesIncludeTax: pricesIncludeTax,
+		},
+		Invoice: InvoiceConfig{
+			SellerName:    getEnv("INVOICE_SELLER_NAME", "Learning Go Shop"),
+			SellerAddress: getEnv("INVOICE_SELLER_ADDRESS", ""),
+			SellerEmail:   getEnv("INVOICE_SELLER_EMAIL", "billing@shop.com"),
+			SellerTaxID:   getEnv("INVOICE_SELLER_TAX_ID", ""),
+		},
+		Checkout: CheckoutConfig{
+			ReservationTTL:           reservationTTL,
+			ReservationSweepInterval: reservationSweepInterval,
+		},
 	}, nil
 
 }
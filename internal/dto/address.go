@@ -0,0 +1,63 @@
+package dto
+
+import "time"
+
+type CreateAddressRequest struct {
+	Label             string `json:"label" binding:"max=100"`
+	FirstName         string `json:"first_name" binding:"required,max=100"`
+	LastName          string `json:"last_name" binding:"required,max=100"`
+	Phone             string `json:"phone" binding:"max=20"`
+	Line1             string `json:"line1" binding:"required,max=255"`
+	Line2             string `json:"line2" binding:"max=255"`
+	City              string `json:"city" binding:"required,max=100"`
+	Region            string `json:"region" binding:"max=100"`
+	PostalCode        string `json:"postal_code" binding:"required,max=20"`
+	Country           string `json:"country" binding:"required,iso3166_1_alpha2"`
+	IsDefaultShipping bool   `json:"is_default_shipping"`
+	IsDefaultBilling  bool   `json:"is_default_billing"`
+}
+
+type UpdateAddressRequest struct {
+	Label             string `json:"label" binding:"max=100"`
+	FirstName         string `json:"first_name" binding:"required,max=100"`
+	LastName          string `json:"last_name" binding:"required,max=100"`
+	Phone             string `json:"phone" binding:"max=20"`
+	Line1             string `json:"line1" binding:"required,max=255"`
+	Line2             string `json:"line2" binding:"max=255"`
+	City              string `json:"city" binding:"required,max=100"`
+	Region            string `json:"region" binding:"max=100"`
+	PostalCode        string `json:"postal_code" binding:"required,max=20"`
+	Country           string `json:"country" binding:"required,iso3166_1_alpha2"`
+	IsDefaultShipping *bool  `json:"is_default_shipping"`
+	IsDefaultBilling  *bool  `json:"is_default_billing"`
+}
+
+type AddressResponse struct {
+	ID                uint      `json:"id"`
+	Label             string    `json:"label"`
+	FirstName         string    `json:"first_name"`
+	LastName          string    `json:"last_name"`
+	Phone             string    `json:"phone"`
+	Line1             string    `json:"line1"`
+	Line2             string    `json:"line2"`
+	City              string    `json:"city"`
+	Region            string    `json:"region"`
+	PostalCode        string    `json:"postal_code"`
+	Country           string    `json:"country"`
+	IsDefaultShipping bool      `json:"is_default_shipping"`
+	IsDefaultBilling  bool      `json:"is_default_billing"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type OrderAddressResponse struct {
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Phone      string `json:"phone"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+}
@@ -8,21 +8,29 @@ type RegisterRequest struct {
 	FirstName string `json:"first_name" binding:"required"`
 	LastName  string `json:"last_name" binding:"required"`
 	Phone     string `json:"phone"`
+	CartToken string `json:"cart_token"`
 }
 
+// LoginRequest signs a user in. CartToken optionally names a guest cart
+// whose items are merged into the user's cart, as it does for RegisterRequest.
 type LoginRequest struct {
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required"`
+	Email     string `json:"email" binding:"required,email"`
+	Password  string `json:"password" binding:"required"`
+	CartToken string `json:"cart_token"`
 }
 
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
+// AuthResponse carries the tokens of a signed in user. CartAdjustments lists
+// the guest cart items that couldn't be merged in full; every other item of
+// the guest cart was added to the user's cart.
 type AuthResponse struct {
-	User         UserResponse `json:"user"`
-	AccessToken  string       `json:"access_token"`
-	RefreshToken string       `json:"refresh_token"`
+	User            UserResponse                  `json:"user"`
+	AccessToken     string                        `json:"access_token"`
+	RefreshToken    string                        `json:"refresh_token"`
+	CartAdjustments []CartMergeAdjustmentResponse `json:"cart_adjustments"`
 }
 
 type UserResponse struct {
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+type ApplyCouponRequest struct {
+	Code string `json:"code" binding:"required,max=50"`
+}
+
+// CreateCouponRequest defines a promotion. PercentOff is used by percentage
+// coupons and AmountOff by fixed_amount coupons. Limits of zero mean unlimited.
+type CreateCouponRequest struct {
+	Code              string      `json:"code" binding:"required,max=50"`
+	Description       string      `json:"description"`
+	Type              string      `json:"type" binding:"required,oneof=percentage fixed_amount free_shipping"`
+	PercentOff        int         `json:"percent_off" binding:"min=0,max=100"`
+	AmountOff         money.Money `json:"amount_off"`
+	MinCartValue      money.Money `json:"min_cart_value"`
+	StartsAt          *time.Time  `json:"starts_at"`
+	ExpiresAt         *time.Time  `json:"expires_at"`
+	UsageLimit        int         `json:"usage_limit" binding:"min=0"`
+	UsageLimitPerUser int         `json:"usage_limit_per_user" binding:"min=0"`
+	ProductIDs        []uint      `json:"product_ids"`
+	CategoryIDs       []uint      `json:"category_ids"`
+}
+
+type CouponResponse struct {
+	ID                uint        `json:"id"`
+	Code              string      `json:"code"`
+	Description       string      `json:"description"`
+	Type              string      `json:"type"`
+	PercentOff        int         `json:"percent_off"`
+	AmountOff         money.Money `json:"amount_off"`
+	MinCartValue      money.Money `json:"min_cart_value"`
+	StartsAt          *time.Time  `json:"starts_at"`
+	ExpiresAt         *time.Time  `json:"expires_at"`
+	UsageLimit        int         `json:"usage_limit"`
+	UsageLimitPerUser int         `json:"usage_limit_per_user"`
+	TimesUsed         int         `json:"times_used"`
+	IsActive          bool        `json:"is_active"`
+	ProductIDs        []uint      `json:"product_ids"`
+	CategoryIDs       []uint      `json:"category_ids"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+}
+
+// CartDiscountResponse is a discount line on the cart. When the coupon no
+// longer applies to the cart, Amount is zero and Message says why.
+type CartDiscountResponse struct {
+	Code        string      `json:"code"`
+	Description string      `json:"description"`
+	Type        string      `json:"type"`
+	Amount      money.Money `json:"amount"`
+	Message     string      `json:"message,omitempty"`
+}
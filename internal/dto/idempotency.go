@@ -0,0 +1,8 @@
+package dto
+
+// IdempotentResponse is a stored response replayed for a repeated Idempotency-Key.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -1,48 +1,294 @@
 package dto
 
-import "time"
+import (
+	"time"
 
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// AddToCartRequest adds a product to the cart. Products sold as variants
+// need the VariantID of the variant to add.
 type AddToCartRequest struct {
-	ProductID uint `json:"product_id" binding:"required"`
-	Quantity  int  `json:"quantity" binding:"required,min=1"`
+	ProductID uint  `json:"product_id" binding:"required"`
+	VariantID *uint `json:"variant_id"`
+	Quantity  int   `json:"quantity" binding:"required,min=1"`
 }
 
 type UpdateCartItemRequest struct {
 	Quantity int `json:"quantity" binding:"required,min=1"`
 }
 
+// CartResponse carries the cart totals. Total is the grand total: the subtotal
+// less discounts, plus TaxTotal unless PricesIncludeTax is set.
 type CartResponse struct {
-	ID        uint               `json:"id"`
-	UserID    uint               `json:"user_id"`
-	CartItems []CartItemResponse `json:"cart_items"`
-	Total     float64            `json:"total"`
-	CreatedAt time.Time          `json:"created_at"`
-	UpdatedAt time.Time          `json:"updated_at"`
+	ID               uint                   `json:"id"`
+	UserID           *uint                  `json:"user_id"`
+	CartItems        []CartItemResponse     `json:"cart_items"`
+	Subtotal         money.Money            `json:"subtotal"`
+	Discounts        []CartDiscountResponse `json:"discounts"`
+	TaxTotal         money.Money            `json:"tax_total"`
+	Total            money.Money            `json:"total"`
+	PricesIncludeTax bool                   `json:"prices_include_tax"`
+	CreatedAt        time.Time              `json:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
+}
+
+// GuestCartResponse is a new guest cart. CartToken must be sent in the
+// X-Cart-Token header to use the cart.
+type GuestCartResponse struct {
+	CartToken string       `json:"cart_token"`
+	Cart      CartResponse `json:"cart"`
+}
+
+// ReorderResponse is the cart after a past order was copied into it.
+// Adjustments lists the order lines that were skipped or added with a
+// smaller quantity; every other line was added in full.
+type ReorderResponse struct {
+	Cart        CartResponse                `json:"cart"`
+	Adjustments []ReorderAdjustmentResponse `json:"adjustments"`
+}
+
+const (
+	ReorderStatusSkipped  = "skipped"
+	ReorderStatusAdjusted = "adjusted"
+)
+
+type ReorderAdjustmentResponse struct {
+	OrderItemID       uint   `json:"order_item_id"`
+	ProductID         uint   `json:"product_id"`
+	ProductName       string `json:"product_name"`
+	RequestedQuantity int    `json:"requested_quantity"`
+	AddedQuantity     int    `json:"added_quantity"`
+	Status            string `json:"status"`
+	Reason            string `json:"reason"`
+}
+
+// StockReservationResponse is the stock held for a cart being checked out.
+// Checkout before ExpiresAt is guaranteed the reserved quantities.
+type StockReservationResponse struct {
+	ExpiresAt time.Time                      `json:"expires_at"`
+	Items     []StockReservationItemResponse `json:"items"`
+}
+
+type StockReservationItemResponse struct {
+	ProductID   uint   `json:"product_id"`
+	VariantID   *uint  `json:"variant_id"`
+	ProductName string `json:"product_name"`
+	Quantity    int    `json:"quantity"`
 }
 
+// CartMergeAdjustmentResponse reports a guest cart item that was skipped or
+// added with a smaller quantity when the guest cart was merged on sign in.
+type CartMergeAdjustmentResponse struct {
+	ProductID         uint   `json:"product_id"`
+	ProductName       string `json:"product_name"`
+	RequestedQuantity int    `json:"requested_quantity"`
+	AddedQuantity     int    `json:"added_quantity"`
+	Status            string `json:"status"`
+	Reason            string `json:"reason"`
+}
+
+const (
+	CartMergeStatusSkipped  = "skipped"
+	CartMergeStatusAdjusted = "adjusted"
+)
+
 type CartItemResponse struct {
-	ID        uint            `json:"id"`
-	Product   ProductResponse `json:"product"`
-	Quantity  int             `json:"quantity"`
-	Subtotal  float64         `json:"subtotal"`
-	CreatedAt time.Time       `json:"created_at"`
-	UpdatedAt time.Time       `json:"updated_at"`
+	ID        uint                    `json:"id"`
+	Product   ProductResponse         `json:"product"`
+	Variant   *ProductVariantResponse `json:"variant"`
+	Quantity  int                     `json:"quantity"`
+	Subtotal  money.Money             `json:"subtotal"`
+	TaxAmount money.Money             `json:"tax_amount"`
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
 }
 
 type OrderResponse struct {
-	ID          uint                `json:"id"`
-	UserID      uint                `json:"user_id"`
-	Status      string              `json:"status"`
-	TotalAmount float64             `json:"total_amount"`
-	OrderItems  []OrderItemResponse `json:"order_items"`
-	CreatedAt   time.Time           `json:"created_at"`
-	UpdatedAt   time.Time           `json:"updated_at"`
+	ID                 uint                   `json:"id"`
+	UserID             *uint                  `json:"user_id"`
+	GuestEmail         *string                `json:"guest_email"`
+	Customer           *OrderCustomerResponse `json:"customer,omitempty"`
+	Status             string                 `json:"status"`
+	SubtotalAmount     money.Money            `json:"subtotal_amount"`
+	DiscountAmount     money.Money            `json:"discount_amount"`
+	CouponCode         string                 `json:"coupon_code"`
+	ShippingMethodID   *uint                  `json:"shipping_method_id"`
+	ShippingMethodName string                 `json:"shipping_method_name"`
+	ShippingAmount     money.Money            `json:"shipping_amount"`
+	TaxAmount          money.Money            `json:"tax_amount"`
+	TotalAmount        money.Money            `json:"total_amount"`
+	RefundedAmount     money.Money            `json:"refunded_amount"`
+	PricesIncludeTax   bool                   `json:"prices_include_tax"`
+	OrderItems         []OrderItemResponse    `json:"order_items"`
+	ConfirmedAt        *time.Time             `json:"confirmed_at"`
+	ShippedAt          *time.Time             `json:"shipped_at"`
+	DeliveredAt        *time.Time             `json:"delivered_at"`
+	CancelledAt        *time.Time             `json:"cancelled_at"`
+	CancellationReason string                 `json:"cancellation_reason"`
+	ShippingAddress    OrderAddressResponse   `json:"shipping_address"`
+	BillingAddress     OrderAddressResponse   `json:"billing_address"`
+	Payments           []PaymentResponse      `json:"payments"`
+	Shipments          []ShipmentResponse     `json:"shipments"`
+	InvoiceNumber      string                 `json:"invoice_number"`
+	CreatedAt          time.Time              `json:"created_at"`
+	UpdatedAt          time.Time              `json:"updated_at"`
 }
 
+// OrderItemResponse carries the product details captured at checkout.
+// Product holds the current catalogue entry and may have changed since.
 type OrderItemResponse struct {
-	ID        uint            `json:"id"`
-	Product   ProductResponse `json:"product"`
-	Quantity  int             `json:"quantity"`
-	Price     float64         `json:"price"`
-	CreatedAt time.Time       `json:"created_at"`
+	ID                 uint            `json:"id"`
+	ProductID          uint            `json:"product_id"`
+	VariantID          *uint           `json:"variant_id"`
+	ProductName        string          `json:"product_name"`
+	VariantName        string          `json:"variant_name"`
+	SKU                string          `json:"sku"`
+	UnitPrice          money.Money     `json:"unit_price"`
+	LineTotal          money.Money     `json:"line_total"`
+	DiscountAmount     money.Money     `json:"discount_amount"`
+	TaxAmount          money.Money     `json:"tax_amount"`
+	TaxRateBasisPoints int             `json:"tax_rate_basis_points"`
+	ImageURL           string          `json:"image_url"`
+	Product            ProductResponse `json:"product"`
+	Quantity           int             `json:"quantity"`
+	Price              money.Money     `json:"price"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+// AdminOrderFilter narrows the order book across all users. CreatedFrom is
+// inclusive and CreatedTo exclusive, both RFC 3339. UserEmail must match the
+// customer's email, or the email of a guest order, exactly, ignoring case. Orders are sorted by created_at or
+// total, in descending order unless SortOrder is asc.
+type AdminOrderFilter struct {
+	Status      string       `form:"status" json:"status" binding:"omitempty,oneof=pending confirmed shipped delivered cancelled"`
+	CreatedFrom *time.Time   `form:"created_from" json:"created_from"`
+	CreatedTo   *time.Time   `form:"created_to" json:"created_to"`
+	UserEmail   string       `form:"user_email" json:"user_email" binding:"omitempty,email"`
+	MinTotal    *money.Money `form:"min_total" json:"min_total"`
+	SortBy      string       `form:"sort_by" json:"sort_by" binding:"omitempty,oneof=created_at total"`
+	SortOrder   string       `form:"sort_order" json:"sort_order" binding:"omitempty,oneof=asc desc"`
+}
+
+// OrderCustomerResponse identifies the customer who placed an order. It is
+// only included in admin responses.
+type OrderCustomerResponse struct {
+	ID        uint   `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Phone     string `json:"phone"`
+}
+
+// UpdateOrderStatusRequest moves an order by hand. Orders only become shipped
+// and delivered through their shipments.
+type UpdateOrderStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=pending confirmed cancelled"`
+}
+
+// CreateOrderRequest selects the addresses from the user's address book. When an
+// ID is omitted the user's default address is used, and billing falls back to shipping.
+// Without a ShippingMethodID the cheapest method for the shipping address is used.
+type CreateOrderRequest struct {
+	ShippingAddressID *uint  `json:"shipping_address_id"`
+	BillingAddressID  *uint  `json:"billing_address_id"`
+	ShippingMethodID  *uint  `json:"shipping_method_id"`
+	PaymentToken      string `json:"payment_token" binding:"max=255"`
+}
+
+// GuestCheckoutRequest places an order for a guest cart. The addresses are
+// given in full as guests have no address book, and billing falls back to
+// shipping when it is omitted.
+type GuestCheckoutRequest struct {
+	Email            string               `json:"email" binding:"required,email,max=255"`
+	ShippingAddress  GuestAddressRequest  `json:"shipping_address" binding:"required"`
+	BillingAddress   *GuestAddressRequest `json:"billing_address"`
+	ShippingMethodID *uint                `json:"shipping_method_id"`
+	PaymentToken     string               `json:"payment_token" binding:"max=255"`
+}
+
+type GuestAddressRequest struct {
+	FirstName  string `json:"first_name" binding:"required,max=100"`
+	LastName   string `json:"last_name" binding:"required,max=100"`
+	Phone      string `json:"phone" binding:"max=20"`
+	Line1      string `json:"line1" binding:"required,max=255"`
+	Line2      string `json:"line2" binding:"max=255"`
+	City       string `json:"city" binding:"required,max=100"`
+	Region     string `json:"region" binding:"max=100"`
+	PostalCode string `json:"postal_code" binding:"required,max=20"`
+	Country    string `json:"country" binding:"required,iso3166_1_alpha2"`
+}
+
+// GuestOrderResponse is a new guest order. AccessToken is only returned
+// here and is needed, with the email, to look the order up again.
+type GuestOrderResponse struct {
+	AccessToken string        `json:"access_token"`
+	Order       OrderResponse `json:"order"`
+}
+
+type GuestOrderLookupRequest struct {
+	Email       string `json:"email" binding:"required,email"`
+	AccessToken string `json:"access_token" binding:"required"`
+}
+
+type PaymentResponse struct {
+	ID            uint        `json:"id"`
+	Provider      string      `json:"provider"`
+	TransactionID string      `json:"transaction_id"`
+	Status        string      `json:"status"`
+	Amount        money.Money `json:"amount"`
+	FailureReason string      `json:"failure_reason"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// CreateShipmentRequest ships some or all of an order in one parcel. Without
+// Items everything not yet shipped goes in the parcel.
+type CreateShipmentRequest struct {
+	Carrier        string                `json:"carrier" binding:"required,max=100"`
+	TrackingNumber string                `json:"tracking_number" binding:"required,max=100"`
+	Items          []ShipmentItemRequest `json:"items" binding:"dive"`
+}
+
+type ShipmentItemRequest struct {
+	OrderItemID uint `json:"order_item_id" binding:"required"`
+	Quantity    int  `json:"quantity" binding:"required,min=1"`
+}
+
+type ShipmentResponse struct {
+	ID             uint                   `json:"id"`
+	Carrier        string                 `json:"carrier"`
+	TrackingNumber string                 `json:"tracking_number"`
+	Status         string                 `json:"status"`
+	Items          []ShipmentItemResponse `json:"items"`
+	ShippedAt      time.Time              `json:"shipped_at"`
+	DeliveredAt    *time.Time             `json:"delivered_at"`
+}
+
+type ShipmentItemResponse struct {
+	ID          uint   `json:"id"`
+	OrderItemID uint   `json:"order_item_id"`
+	ProductName string `json:"product_name"`
+	SKU         string `json:"sku"`
+	Quantity    int    `json:"quantity"`
+}
+
+// InvoiceFile is a rendered invoice ready to be downloaded.
+type InvoiceFile struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+type CancelOrderRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type OrderStatusChangedEvent struct {
+	OrderID        uint      `json:"order_id"`
+	UserID         *uint     `json:"user_id"`
+	GuestEmail     *string   `json:"guest_email"`
+	PreviousStatus string    `json:"previous_status"`
+	Status         string    `json:"status"`
+	ChangedAt      time.Time `json:"changed_at"`
 }
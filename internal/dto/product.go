@@ -1,78 +1,268 @@
 package dto
 
-import "time"
+import (
+	"time"
 
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// CreateCategoryRequest creates a category, under ParentID when it is set.
+// Without a slug one is made from the name.
 type CreateCategoryRequest struct {
+	ParentID    *uint  `json:"parent_id"`
 	Name        string `json:"name" binding:"required"`
+	Slug        string `json:"slug" binding:"omitempty,max=255"`
 	Description string `json:"description"`
 }
 
+// UpdateCategoryRequest replaces a category's details. Without a ParentID
+// the category moves to the top level, and without a slug it keeps its own.
 type UpdateCategoryRequest struct {
+	ParentID    *uint  `json:"parent_id"`
 	Name        string `json:"name" binding:"required"`
+	Slug        string `json:"slug" binding:"omitempty,max=255"`
 	Description string `json:"description"`
 	IsActive    *bool  `json:"is_active"`
 }
 
 type CategoryResponse struct {
 	ID          uint      `json:"id"`
+	ParentID    *uint     `json:"parent_id"`
 	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
 	Description string    `json:"description"`
 	IsActive    bool      `json:"is_active"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// CategoryTreeResponse is a category with the categories under it.
+type CategoryTreeResponse struct {
+	CategoryResponse
+	Children []CategoryTreeResponse `json:"children"`
+}
+
+// CategoryBreadcrumbResponse is one step on the path from a top-level
+// category down to a category.
+type CategoryBreadcrumbResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// ProductFilter narrows a product listing to a category, and the categories
+// under it when IncludeSubcategories is set. Products are newest first unless
+// Sort says otherwise.
+type ProductFilter struct {
+	CategoryID           *uint  `form:"category_id" json:"category_id"`
+	IncludeSubcategories bool   `form:"include_subcategories" json:"include_subcategories"`
+	Sort                 string `form:"sort" json:"sort" binding:"omitempty,oneof=newest price_asc price_desc popularity"`
+}
+
 type CreateProductRequest struct {
-	CategoryID  uint    `json:"category_id" binding:"required"`
-	Name        string  `json:"name" binding:"required"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" binding:"required,gt=0"`
-	Stock       int     `json:"stock" binding:"min=0"`
-	SKU         string  `json:"sku" binding:"required"`
+	CategoryID  uint        `json:"category_id" binding:"required"`
+	Name        string      `json:"name" binding:"required"`
+	Description string      `json:"description"`
+	Price       money.Money `json:"price"`
+	Stock       int         `json:"stock" binding:"min=0"`
+	SKU         string      `json:"sku" binding:"required"`
+	TaxClass    string      `json:"tax_class" binding:"max=50"`
+	WeightGrams int         `json:"weight_grams" binding:"min=0"`
 }
 
 type UpdateProductRequest struct {
-	CategoryID  uint    `json:"category_id" binding:"required"`
-	Name        string  `json:"name" binding:"required"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" binding:"required,gt=0"`
-	Stock       int     `json:"stock" binding:"min=0"`
-	IsActive    *bool   `json:"is_active"`
+	CategoryID  uint        `json:"category_id" binding:"required"`
+	Name        string      `json:"name" binding:"required"`
+	Description string      `json:"description"`
+	Price       money.Money `json:"price"`
+	Stock       int         `json:"stock" binding:"min=0"`
+	TaxClass    string      `json:"tax_class" binding:"max=50"`
+	WeightGrams int         `json:"weight_grams" binding:"min=0"`
+	IsActive    *bool       `json:"is_active"`
+}
+
+// CreateProductOptionRequest adds an option such as size or colour to a
+// product, with the values it can take in the order given.
+type CreateProductOptionRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	Values []string `json:"values" binding:"required,min=1,dive,required,max=100"`
 }
 
+// CreateProductVariantRequest adds a variant of a product. It needs a value
+// for each of the product's options. Without a price the product's is used.
+type CreateProductVariantRequest struct {
+	SKU          string                      `json:"sku" binding:"required,max=100"`
+	Price        *money.Money                `json:"price"`
+	Stock        int                         `json:"stock" binding:"min=0"`
+	OptionValues []VariantOptionValueRequest `json:"option_values" binding:"required,min=1,dive"`
+}
+
+type VariantOptionValueRequest struct {
+	Option string `json:"option" binding:"required"`
+	Value  string `json:"value" binding:"required"`
+}
+
+type UpdateProductVariantRequest struct {
+	Price    *money.Money `json:"price"`
+	Stock    int          `json:"stock" binding:"min=0"`
+	IsActive *bool        `json:"is_active"`
+}
+
+// ProductResponse is a catalogue entry. AvailableStock is Stock less what is
+// reserved for carts being checked out. Products with variants are sold as
+// one of their variants, which have their own stock.
 type ProductResponse struct {
-	ID          uint                   `json:"id"`
-	CategoryID  uint                   `json:"category_id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description"`
-	Price       float64                `json:"price"`
-	Stock       int                    `json:"stock"`
-	SKU         string                 `json:"sku"`
-	IsActive    bool                   `json:"is_active"`
-	Category    CategoryResponse       `json:"category"`
-	Images      []ProductImageResponse `json:"images"`
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
+	ID             uint                     `json:"id"`
+	CategoryID     uint                     `json:"category_id"`
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	Price          money.Money              `json:"price"`
+	Stock          int                      `json:"stock"`
+	AvailableStock int                      `json:"available_stock"`
+	SKU            string                   `json:"sku"`
+	TaxClass       string                   `json:"tax_class"`
+	WeightGrams    int                      `json:"weight_grams"`
+	IsActive       bool                     `json:"is_active"`
+	Category       CategoryResponse         `json:"category"`
+	Images         []ProductImageResponse   `json:"images"`
+	Options        []ProductOptionResponse  `json:"options"`
+	Variants       []ProductVariantResponse `json:"variants"`
+	CreatedAt      time.Time                `json:"created_at"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+}
+
+type ProductOptionResponse struct {
+	ID     uint     `json:"id"`
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// ProductVariantResponse is a variant of a product. Price is what the variant
+// sells for, which is the product's price unless the variant overrides it.
+type ProductVariantResponse struct {
+	ID             uint                         `json:"id"`
+	SKU            string                       `json:"sku"`
+	Name           string                       `json:"name"`
+	Price          money.Money                  `json:"price"`
+	Stock          int                          `json:"stock"`
+	AvailableStock int                          `json:"available_stock"`
+	IsActive       bool                         `json:"is_active"`
+	OptionValues   []VariantOptionValueResponse `json:"option_values"`
+	Images         []ProductImageResponse       `json:"images"`
+	CreatedAt      time.Time                    `json:"created_at"`
+	UpdatedAt      time.Time                    `json:"updated_at"`
+}
+
+type VariantOptionValueResponse struct {
+	Option string `json:"option"`
+	Value  string `json:"value"`
 }
 
 type ProductImageResponse struct {
 	ID        uint      `json:"id"`
+	VariantID *uint     `json:"variant_id"`
 	URL       string    `json:"url"`
 	AltText   string    `json:"alt_text"`
 	IsPrimary bool      `json:"is_primary"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// SearchProductsRequest is a full-text product search. Attributes filter on
+// variant option values written as "option:value", e.g. "Size:M". Values of
+// the same option match either, and different options must all match.
+// Results are best match first unless Sort says otherwise.
 type SearchProductsRequest struct {
-	Query      string   `form:"q" binding:"required,min=1"`
-	Page       int      `form:"page"`
-	Limit      int      `form:"limit"`
-	CategoryID *uint    `form:"category_id"`
-	MinPrice   *float64 `form:"min_price"`
-	MaxPrice   *float64 `form:"max_price"`
+	Query      string       `form:"q" json:"query" binding:"required,min=1"`
+	Page       int          `form:"page" json:"-"`
+	Limit      int          `form:"limit" json:"-"`
+	CategoryID *uint        `form:"category_id" json:"category_id"`
+	MinPrice   *money.Money `form:"min_price" json:"min_price"`
+	MaxPrice   *money.Money `form:"max_price" json:"max_price"`
+	InStock    *bool        `form:"in_stock" json:"in_stock"`
+	Attributes []string     `form:"attribute" json:"attributes" binding:"omitempty,dive,contains=:"`
+	Sort       string       `form:"sort" json:"sort" binding:"omitempty,oneof=relevance newest price_asc price_desc popularity"`
+
+	// IncludeSubcategories also matches products in the categories under CategoryID.
+	IncludeSubcategories bool `form:"include_subcategories" json:"include_subcategories"`
 }
 
 type ProductSearchResult struct {
 	ProductResponse
 	Rank float32 `json:"rank"`
 }
+
+// SearchFacetsResponse counts the products matching a search by the values
+// it can be narrowed down by. Each facet applies all of the search's filters
+// but its own, so it shows what choosing another value would match.
+type SearchFacetsResponse struct {
+	Categories  []CategoryFacetResponse   `json:"categories"`
+	PriceRanges []PriceRangeFacetResponse `json:"price_ranges"`
+	Stock       StockFacetResponse        `json:"stock"`
+	Attributes  []AttributeFacetResponse  `json:"attributes"`
+}
+
+type CategoryFacetResponse struct {
+	CategoryID uint   `json:"category_id"`
+	Name       string `json:"name"`
+	Count      int64  `json:"count"`
+}
+
+// PriceRangeFacetResponse counts the products priced from Min up to but not
+// including Max. The last range has no Max.
+type PriceRangeFacetResponse struct {
+	Min   money.Money  `json:"min"`
+	Max   *money.Money `json:"max"`
+	Count int64        `json:"count"`
+}
+
+type StockFacetResponse struct {
+	InStock    int64 `json:"in_stock"`
+	OutOfStock int64 `json:"out_of_stock"`
+}
+
+type AttributeFacetResponse struct {
+	Option string `json:"option"`
+	Value  string `json:"value"`
+	Count  int64  `json:"count"`
+}
+
+// SearchSuggestRequest is a partly typed search query to complete. Limit is
+// how many products and how many categories to suggest, five by default.
+type SearchSuggestRequest struct {
+	Query string `form:"q" binding:"required,max=100"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=10"`
+}
+
+// SearchSuggestionsResponse holds the products and categories whose names
+// complete a search query, best match first. Names that only resemble the
+// query, such as misspellings of it, come after those that complete it.
+type SearchSuggestionsResponse struct {
+	Products   []ProductSuggestionResponse  `json:"products"`
+	Categories []CategorySuggestionResponse `json:"categories"`
+}
+
+type ProductSuggestionResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type CategorySuggestionResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// CreateSearchSynonymRequest makes searches for Term, a single word, also
+// match Synonym. Searches for Synonym need an entry of their own to match Term.
+type CreateSearchSynonymRequest struct {
+	Term    string `json:"term" binding:"required,max=100"`
+	Synonym string `json:"synonym" binding:"required,max=100"`
+}
+
+type SearchSynonymResponse struct {
+	ID        uint      `json:"id"`
+	Term      string    `json:"term"`
+	Synonym   string    `json:"synonym"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// CreateReturnRequest opens a return for items of a delivered order.
+type CreateReturnRequest struct {
+	Reason string              `json:"reason" binding:"required,max=1000"`
+	Items  []ReturnItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+type ReturnItemRequest struct {
+	OrderItemID uint `json:"order_item_id" binding:"required"`
+	Quantity    int  `json:"quantity" binding:"required,min=1"`
+}
+
+type RejectReturnRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// RefundReturnRequest refunds a received return. Without an Amount, what the
+// customer paid for the returned items is refunded.
+type RefundReturnRequest struct {
+	Amount *money.Money `json:"amount"`
+}
+
+type ReturnResponse struct {
+	ID              uint                 `json:"id"`
+	OrderID         uint                 `json:"order_id"`
+	UserID          uint                 `json:"user_id"`
+	Status          string               `json:"status"`
+	Reason          string               `json:"reason"`
+	RejectionReason string               `json:"rejection_reason"`
+	RefundAmount    money.Money          `json:"refund_amount"`
+	Items           []ReturnItemResponse `json:"items"`
+	ApprovedAt      *time.Time           `json:"approved_at"`
+	ReceivedAt      *time.Time           `json:"received_at"`
+	RefundedAt      *time.Time           `json:"refunded_at"`
+	RejectedAt      *time.Time           `json:"rejected_at"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+type ReturnItemResponse struct {
+	ID          uint   `json:"id"`
+	OrderItemID uint   `json:"order_item_id"`
+	ProductID   uint   `json:"product_id"`
+	ProductName string `json:"product_name"`
+	SKU         string `json:"sku"`
+	Quantity    int    `json:"quantity"`
+}
+
+type ReturnStatusChangedEvent struct {
+	ReturnID       uint        `json:"return_id"`
+	OrderID        uint        `json:"order_id"`
+	UserID         uint        `json:"user_id"`
+	PreviousStatus string      `json:"previous_status"`
+	Status         string      `json:"status"`
+	RefundAmount   money.Money `json:"refund_amount"`
+	ChangedAt      time.Time   `json:"changed_at"`
+}
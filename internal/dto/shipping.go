@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// ShippingOptionsRequest selects the destination to quote shipping for: an
+// address from the user's address book, or a country and optional region.
+// When neither is given the user's default shipping address is used.
+type ShippingOptionsRequest struct {
+	AddressID *uint  `form:"address_id" json:"address_id"`
+	Country   string `form:"country" json:"country" binding:"omitempty,iso3166_1_alpha2"`
+	Region    string `form:"region" json:"region" binding:"max=100"`
+}
+
+// GuestShippingOptionsRequest selects the destination to quote shipping for
+// a guest cart.
+type GuestShippingOptionsRequest struct {
+	Country string `form:"country" json:"country" binding:"required,iso3166_1_alpha2"`
+	Region  string `form:"region" json:"region" binding:"max=100"`
+}
+
+// ShippingOptionResponse is a shipping method quoted for the current cart.
+type ShippingOptionResponse struct {
+	MethodID uint        `json:"method_id"`
+	Name     string      `json:"name"`
+	Type     string      `json:"type"`
+	ZoneName string      `json:"zone_name"`
+	Cost     money.Money `json:"cost"`
+}
+
+// CreateShippingZoneRequest defines a zone. A location without a region covers
+// the whole country, and a zone without locations covers every destination
+// that no other zone does.
+type CreateShippingZoneRequest struct {
+	Name      string                        `json:"name" binding:"required,max=100"`
+	Locations []ShippingZoneLocationRequest `json:"locations" binding:"dive"`
+}
+
+type ShippingZoneLocationRequest struct {
+	Country string `json:"country" binding:"required,iso3166_1_alpha2"`
+	Region  string `json:"region" binding:"max=100"`
+}
+
+// CreateShippingMethodRequest defines a method of a zone. Rate is the flat
+// price, or the base price of weight_based methods, which add PerKgRate for
+// every started kilogram. free_over_threshold methods charge Rate unless the
+// discounted cart reaches FreeThreshold.
+type CreateShippingMethodRequest struct {
+	Name          string      `json:"name" binding:"required,max=100"`
+	Type          string      `json:"type" binding:"required,oneof=flat_rate weight_based free_over_threshold"`
+	Rate          money.Money `json:"rate"`
+	PerKgRate     money.Money `json:"per_kg_rate"`
+	FreeThreshold money.Money `json:"free_threshold"`
+}
+
+type ShippingZoneResponse struct {
+	ID        uint                           `json:"id"`
+	Name      string                         `json:"name"`
+	Locations []ShippingZoneLocationResponse `json:"locations"`
+	Methods   []ShippingMethodResponse       `json:"methods"`
+	CreatedAt time.Time                      `json:"created_at"`
+	UpdatedAt time.Time                      `json:"updated_at"`
+}
+
+type ShippingZoneLocationResponse struct {
+	Country string `json:"country"`
+	Region  string `json:"region"`
+}
+
+type ShippingMethodResponse struct {
+	ID            uint        `json:"id"`
+	ZoneID        uint        `json:"zone_id"`
+	Name          string      `json:"name"`
+	Type          string      `json:"type"`
+	Rate          money.Money `json:"rate"`
+	PerKgRate     money.Money `json:"per_kg_rate"`
+	FreeThreshold money.Money `json:"free_threshold"`
+	IsActive      bool        `json:"is_active"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
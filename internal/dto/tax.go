@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// CreateTaxRateRequest sets the rate for a tax class in a country, or in one of
+// its regions. BasisPoints is the rate in hundredths of a percent, so 2000 is 20%.
+type CreateTaxRateRequest struct {
+	Country     string `json:"country" binding:"required,iso3166_1_alpha2"`
+	Region      string `json:"region" binding:"max=100"`
+	TaxClass    string `json:"tax_class" binding:"required,max=50"`
+	Name        string `json:"name" binding:"max=100"`
+	BasisPoints int    `json:"basis_points" binding:"min=0,max=10000"`
+}
+
+type TaxRateResponse struct {
+	ID          uint      `json:"id"`
+	Country     string    `json:"country"`
+	Region      string    `json:"region"`
+	TaxClass    string    `json:"tax_class"`
+	Name        string    `json:"name"`
+	BasisPoints int       `json:"basis_points"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
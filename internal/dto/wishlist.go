@@ -0,0 +1,40 @@
+package dto
+
+import "time"
+
+type CreateWishlistRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type UpdateWishlistRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+type AddWishlistItemRequest struct {
+	ProductID uint `json:"product_id" binding:"required"`
+}
+
+// MoveWishlistItemRequest is how many of a wishlist item's product to put in
+// the cart. Without a quantity one is added. Products sold as variants need
+// the VariantID of the variant to add.
+type MoveWishlistItemRequest struct {
+	VariantID *uint `json:"variant_id"`
+	Quantity  int   `json:"quantity" binding:"omitempty,min=1"`
+}
+
+// WishlistResponse is a wishlist with its items. ShareToken is only set while
+// the list is shared.
+type WishlistResponse struct {
+	ID         uint                   `json:"id"`
+	Name       string                 `json:"name"`
+	ShareToken *string                `json:"share_token"`
+	Items      []WishlistItemResponse `json:"items"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+type WishlistItemResponse struct {
+	ID        uint            `json:"id"`
+	Product   ProductResponse `json:"product"`
+	CreatedAt time.Time       `json:"created_at"`
+}
@@ -0,0 +1,39 @@
+package interfaces
+
+import (
+	"errors"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// PaymentRequest describes an amount to authorize for an order.
+type PaymentRequest struct {
+	OrderID uint
+	Amount  money.Money
+
+	// Token identifies the customer's payment method at the provider.
+	Token string
+}
+
+// PaymentResult is the provider's view of a transaction after an operation or
+// webhook. A declined payment is reported as PaymentStatusFailed, not as an error.
+type PaymentResult struct {
+	TransactionID string
+	Status        models.PaymentStatus
+	FailureReason string
+}
+
+type PaymentProvider interface {
+	Name() string
+	Authorize(req PaymentRequest) (*PaymentResult, error)
+	Capture(transactionID string, amount money.Money) (*PaymentResult, error)
+	Refund(transactionID string, amount money.Money) (*PaymentResult, error)
+	Void(transactionID string) (*PaymentResult, error)
+
+	// ParseWebhook verifies the signature of an asynchronous notification and
+	// returns the transaction result it carries.
+	ParseWebhook(payload []byte, signature string) (*PaymentResult, error)
+}
@@ -1,8 +1,19 @@
 package interfaces
 
-import "mime/multipart"
+import (
+	"io"
+	"mime/multipart"
+)
 
 type UploadProvider interface {
 	UploadFile(file *multipart.FileHeader, path string) (string, error)
+
+	// SaveFile stores content generated by the application, such as a
+	// rendered document, at path.
+	SaveFile(content io.Reader, path, contentType string) error
+
+	// OpenFile returns the content stored at path. The caller must close it.
+	OpenFile(path string) (io.ReadCloser, error)
+
 	DeleteFile(path string) error
 }
@@ -0,0 +1,275 @@
+// Package invoice renders order invoices as PDF documents.
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// ContentType is the media type of a rendered invoice.
+const ContentType = "application/pdf"
+
+// Seller identifies the business issuing the invoices.
+type Seller struct {
+	Name    string
+	Address string
+	Email   string
+	TaxID   string
+}
+
+// columns are the columns of the line item table. Widths are in millimetres
+// and add up to the 180mm between the page margins.
+var columns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"Item", 62, "L"},
+	{"Qty", 14, "R"},
+	{"Unit price", 24, "R"},
+	{"Discount", 22, "R"},
+	{"Tax rate", 16, "R"},
+	{"Tax", 20, "R"},
+	{"Amount", 22, "R"},
+}
+
+// taxLine is the tax charged at one rate across the invoice.
+type taxLine struct {
+	label   string
+	taxable money.Money
+	tax     money.Money
+}
+
+// Render lays out the invoice for order. The document only depends on its
+// arguments, including its embedded dates, so rendering the same invoice
+// twice gives the same bytes.
+func Render(seller Seller, invoice *models.Invoice, order *models.Order) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.SetCreationDate(invoice.IssuedAt)
+	pdf.SetModificationDate(invoice.IssuedAt)
+	pdf.SetCatalogSort(true)
+	pdf.SetTitle("Invoice "+invoice.Number, true)
+	pdf.SetAuthor(seller.Name, true)
+
+	// The core fonts only cover cp1252, so text is translated to it
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+
+	// Title and invoice details
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(90, 10, "INVOICE", "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(90, 5, tr("Invoice no: "+invoice.Number), "", 2, "R", false, 0, "")
+	pdf.CellFormat(90, 5, "Issue date: "+invoice.IssuedAt.Format("2006-01-02"), "", 2, "R", false, 0, "")
+	pdf.CellFormat(90, 5, fmt.Sprintf("Order no: %d", order.ID), "", 1, "R", false, 0, "")
+	pdf.Ln(6)
+
+	// Seller, billing and shipping details side by side
+	top := pdf.GetY()
+	bottom := max(
+		addressBlock(pdf, tr, 15, top, "From", sellerLines(seller)),
+		addressBlock(pdf, tr, 75, top, "Bill to", orderAddressLines(&order.BillingAddress)),
+		addressBlock(pdf, tr, 135, top, "Ship to", orderAddressLines(&order.ShippingAddress)),
+	)
+	pdf.SetY(bottom)
+	pdf.Ln(8)
+
+	// Line items
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.SetFillColor(235, 235, 235)
+	for _, column := range columns {
+		pdf.CellFormat(column.width, 7, column.title, "B", 0, column.align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	rates := make(map[int]*taxLine)
+	lineTax := money.New(0)
+	for i := range order.OrderItems {
+		item := &order.OrderItems[i]
+		amount := item.LineTotal.Sub(item.DiscountAmount)
+
+		description := item.DisplayName()
+		if item.ProductSKU != "" {
+			description += " (" + item.ProductSKU + ")"
+		}
+		tableRow(pdf, tr, description,
+			fmt.Sprintf("%d", item.Quantity),
+			item.UnitPrice.Decimal(),
+			item.DiscountAmount.Decimal(),
+			formatRate(item.TaxRateBasisPoints),
+			item.TaxAmount.Decimal(),
+			amount.Decimal())
+
+		line, ok := rates[item.TaxRateBasisPoints]
+		if !ok {
+			line = &taxLine{label: formatRate(item.TaxRateBasisPoints), taxable: money.New(0), tax: money.New(0)}
+			rates[item.TaxRateBasisPoints] = line
+		}
+		line.taxable = line.taxable.Add(amount)
+		line.tax = line.tax.Add(item.TaxAmount)
+		lineTax = lineTax.Add(item.TaxAmount)
+	}
+
+	// Tax on shipping is only stored as part of the order's tax total
+	shippingTax := order.TaxAmount.Sub(lineTax)
+	if order.ShippingMethodName != "" || !order.ShippingAmount.IsZero() {
+		name := order.ShippingMethodName
+		if name == "" {
+			name = "Shipping"
+		}
+		tableRow(pdf, tr, "Shipping: "+name, "1",
+			order.ShippingAmount.Decimal(),
+			money.New(0).Decimal(),
+			"",
+			shippingTax.Decimal(),
+			order.ShippingAmount.Decimal())
+	}
+	pdf.Ln(4)
+
+	// Totals
+	currency := order.TotalAmount.Currency
+	taxLabel := "Tax"
+	if order.PricesIncludeTax {
+		taxLabel = "Tax (included in prices)"
+	}
+	totalRow(pdf, "Subtotal", order.SubtotalAmount.Decimal(), false)
+	if order.DiscountAmount.IsPositive() {
+		discount := "-" + order.DiscountAmount.Decimal()
+		if order.CouponCode != "" {
+			totalRow(pdf, tr("Discount ("+order.CouponCode+")"), discount, false)
+		} else {
+			totalRow(pdf, "Discount", discount, false)
+		}
+	}
+	totalRow(pdf, "Shipping", order.ShippingAmount.Decimal(), false)
+	totalRow(pdf, taxLabel, order.TaxAmount.Decimal(), false)
+	totalRow(pdf, "Total ("+currency+")", order.TotalAmount.Decimal(), true)
+	pdf.Ln(6)
+
+	// Tax summary per rate
+	summary := make([]*taxLine, 0, len(rates)+1)
+	for _, rate := range slices.Sorted(maps.Keys(rates)) {
+		summary = append(summary, rates[rate])
+	}
+	if !shippingTax.IsZero() {
+		summary = append(summary, &taxLine{label: "Shipping", taxable: order.ShippingAmount, tax: shippingTax})
+	}
+
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(180, 6, "Tax summary", "", 1, "L", false, 0, "")
+	pdf.CellFormat(40, 6, "Rate", "B", 0, "L", true, 0, "")
+	pdf.CellFormat(40, 6, "Taxable amount", "B", 0, "R", true, 0, "")
+	pdf.CellFormat(40, 6, "Tax", "B", 1, "R", true, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	for _, line := range summary {
+		pdf.CellFormat(40, 6, line.label, "", 0, "L", false, 0, "")
+		pdf.CellFormat(40, 6, line.taxable.Decimal(), "", 0, "R", false, 0, "")
+		pdf.CellFormat(40, 6, line.tax.Decimal(), "", 1, "R", false, 0, "")
+	}
+
+	if order.PricesIncludeTax {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.CellFormat(180, 5, "All prices include tax.", "", 1, "L", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// addressBlock prints a titled block of lines in a 55mm wide column at x, y
+// and returns where the block ends.
+func addressBlock(pdf *fpdf.Fpdf, tr func(string) string, x, y float64, title string, lines []string) float64 {
+	pdf.SetXY(x, y)
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(55, 5, title, "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	for _, line := range lines {
+		pdf.CellFormat(55, 4.5, tr(line), "", 2, "L", false, 0, "")
+	}
+	return pdf.GetY()
+}
+
+// tableRow prints one row of the line item table.
+func tableRow(pdf *fpdf.Fpdf, tr func(string) string, cells ...string) {
+	for i, column := range columns {
+		text := cells[i]
+		if i == 0 {
+			text = truncate(pdf, tr(text), column.width-2)
+		}
+		pdf.CellFormat(column.width, 6, text, "B", 0, column.align, false, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+// totalRow prints a label and amount aligned under the amount column.
+func totalRow(pdf *fpdf.Fpdf, label, amount string, bold bool) {
+	style := ""
+	if bold {
+		style = "B"
+	}
+	pdf.SetFont("Helvetica", style, 9)
+	pdf.CellFormat(120, 6, "", "", 0, "L", false, 0, "")
+	pdf.CellFormat(38, 6, label, "", 0, "R", false, 0, "")
+	pdf.CellFormat(22, 6, amount, "", 1, "R", false, 0, "")
+}
+
+// truncate shortens text that is already in the PDF encoding to fit width.
+func truncate(pdf *fpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	for len(text) > 0 && pdf.GetStringWidth(text+"...") > width {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}
+
+func sellerLines(seller Seller) []string {
+	lines := []string{seller.Name}
+	for _, line := range strings.Split(seller.Address, ",") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if seller.Email != "" {
+		lines = append(lines, seller.Email)
+	}
+	if seller.TaxID != "" {
+		lines = append(lines, "Tax ID: "+seller.TaxID)
+	}
+	return lines
+}
+
+func orderAddressLines(address *models.OrderAddress) []string {
+	lines := []string{strings.TrimSpace(address.FirstName + " " + address.LastName), address.Line1}
+	if address.Line2 != "" {
+		lines = append(lines, address.Line2)
+	}
+	lines = append(lines,
+		strings.TrimSpace(address.PostalCode+" "+address.City),
+		strings.TrimSpace(strings.Trim(address.Region+", "+address.Country, ", ")))
+	if address.Phone != "" {
+		lines = append(lines, address.Phone)
+	}
+	return lines
+}
+
+// formatRate prints a rate in basis points as a percentage, e.g. 2000 as 20.00%.
+func formatRate(basisPoints int) string {
+	return fmt.Sprintf("%d.%02d%%", basisPoints/100, basisPoints%100)
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Address struct {
+	ID                uint           `json:"id" gorm:"primaryKey"`
+	UserID            uint           `json:"user_id" gorm:"not null"`
+	Label             string         `json:"label"`
+	FirstName         string         `json:"first_name" gorm:"not null"`
+	LastName          string         `json:"last_name" gorm:"not null"`
+	Phone             string         `json:"phone"`
+	Line1             string         `json:"line1" gorm:"not null"`
+	Line2             string         `json:"line2"`
+	City              string         `json:"city" gorm:"not null"`
+	Region            string         `json:"region"`
+	PostalCode        string         `json:"postal_code" gorm:"not null"`
+	Country           string         `json:"country" gorm:"not null"`
+	IsDefaultShipping bool           `json:"is_default_shipping" gorm:"default:false"`
+	IsDefaultBilling  bool           `json:"is_default_billing" gorm:"default:false"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relationships
+	User User `json:"-"`
+}
+
+// OrderAddress is the copy of an Address stored on an order at checkout, so
+// editing or deleting the address later doesn't change where the order went.
+type OrderAddress struct {
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Phone      string `json:"phone"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+}
+
+// Snapshot returns the order copy of the address.
+func (a *Address) Snapshot() OrderAddress {
+	return OrderAddress{
+		FirstName:  a.FirstName,
+		LastName:   a.LastName,
+		Phone:      a.Phone,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
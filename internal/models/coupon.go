@@ -0,0 +1,155 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"gorm.io/gorm"
+)
+
+type CouponType string
+
+const (
+	CouponTypePercentage   CouponType = "percentage"
+	CouponTypeFixedAmount  CouponType = "fixed_amount"
+	CouponTypeFreeShipping CouponType = "free_shipping"
+)
+
+// Coupon is a promotion code. PercentOff applies to percentage coupons and
+// AmountOff to fixed amount coupons. A limit of zero means unlimited. When
+// Products or Categories are set, only matching cart items are discounted.
+type Coupon struct {
+	ID                uint           `json:"id" gorm:"primaryKey"`
+	Code              string         `json:"code" gorm:"uniqueIndex;not null"`
+	Description       string         `json:"description"`
+	Type              CouponType     `json:"type" gorm:"not null"`
+	PercentOff        int            `json:"percent_off" gorm:"default:0"`
+	AmountOff         money.Money    `json:"amount_off" gorm:"not null"`
+	MinCartValue      money.Money    `json:"min_cart_value" gorm:"not null"`
+	StartsAt          *time.Time     `json:"starts_at"`
+	ExpiresAt         *time.Time     `json:"expires_at"`
+	UsageLimit        int            `json:"usage_limit" gorm:"default:0"`
+	UsageLimitPerUser int            `json:"usage_limit_per_user" gorm:"default:0"`
+	TimesUsed         int            `json:"times_used" gorm:"default:0"`
+	IsActive          bool           `json:"is_active" gorm:"default:true"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relationships
+	Products   []Product  `json:"products" gorm:"many2many:coupon_products;"`
+	Categories []Category `json:"categories" gorm:"many2many:coupon_categories;"`
+}
+
+// CouponRedemption records a coupon used by an order, which is what per-user
+// usage limits are counted against.
+type CouponRedemption struct {
+	ID        uint        `json:"id" gorm:"primaryKey"`
+	CouponID  uint        `json:"coupon_id" gorm:"not null"`
+	UserID    uint        `json:"user_id" gorm:"not null"`
+	OrderID   uint        `json:"order_id" gorm:"not null"`
+	Amount    money.Money `json:"amount" gorm:"not null"`
+	CreatedAt time.Time   `json:"created_at"`
+
+	// Relationships
+	Coupon Coupon `json:"-"`
+	Order  Order  `json:"-"`
+}
+
+// CheckAvailable reports why the coupon can't be used at the given time, if at
+// all. Per-user limits need the user's redemptions and are checked separately.
+func (c *Coupon) CheckAvailable(at time.Time) error {
+	if !c.IsActive {
+		return errors.New("coupon is not active")
+	}
+	if c.StartsAt != nil && at.Before(*c.StartsAt) {
+		return errors.New("coupon is not valid yet")
+	}
+	if c.ExpiresAt != nil && !at.Before(*c.ExpiresAt) {
+		return errors.New("coupon has expired")
+	}
+	if c.UsageLimit > 0 && c.TimesUsed >= c.UsageLimit {
+		return errors.New("coupon usage limit reached")
+	}
+	return nil
+}
+
+// Discount returns the amount the coupon takes off the given cart items. The
+// cart items need their Product loaded. Free shipping coupons discount no item
+// amount.
+func (c *Coupon) Discount(items []CartItem) (money.Money, error) {
+	lineDiscounts, err := c.LineDiscounts(items)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	total := money.New(0)
+	for _, discount := range lineDiscounts {
+		total = total.Add(discount)
+	}
+	return total, nil
+}
+
+// LineDiscounts splits the coupon's discount over the cart items, so it can be
+// taken off each line's taxable amount. A fixed amount is spread over the
+// eligible lines in proportion to their totals, with the rounding remainder on
+// the last eligible line.
+func (c *Coupon) LineDiscounts(items []CartItem) ([]money.Money, error) {
+	lineTotals := make([]money.Money, len(items))
+	subtotal := money.New(0)
+	eligible := money.New(0)
+	lastEligible := -1
+	for i := range items {
+		lineTotals[i] = items[i].UnitPrice().Mul(items[i].Quantity)
+		subtotal = subtotal.Add(lineTotals[i])
+		if c.appliesTo(&items[i].Product) {
+			eligible = eligible.Add(lineTotals[i])
+			lastEligible = i
+		}
+	}
+
+	if subtotal.Cmp(c.MinCartValue) < 0 {
+		return nil, errors.New("cart total is below the coupon minimum of " + c.MinCartValue.String())
+	}
+	if eligible.IsZero() {
+		return nil, errors.New("coupon does not apply to any item in the cart")
+	}
+
+	amountOff := c.AmountOff
+	if amountOff.Cmp(eligible) > 0 {
+		amountOff = eligible
+	}
+
+	discounts := make([]money.Money, len(items))
+	allocated := money.New(0)
+	for i := range items {
+		discounts[i] = money.New(0)
+		if !c.appliesTo(&items[i].Product) {
+			continue
+		}
+
+		switch c.Type {
+		case CouponTypePercentage:
+			discounts[i] = lineTotals[i].Mul(c.PercentOff).Div(100)
+		case CouponTypeFixedAmount:
+			if i == lastEligible {
+				discounts[i] = amountOff.Sub(allocated)
+			} else {
+				discounts[i] = money.New(amountOff.Amount * lineTotals[i].Amount / eligible.Amount)
+				allocated = allocated.Add(discounts[i])
+			}
+		}
+	}
+	return discounts, nil
+}
+
+func (c *Coupon) appliesTo(product *Product) bool {
+	if len(c.Products) == 0 && len(c.Categories) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(c.Products, func(p Product) bool { return p.ID == product.ID }) ||
+		slices.ContainsFunc(c.Categories, func(cat Category) bool { return cat.ID == product.CategoryID })
+}
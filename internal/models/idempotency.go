@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// IdempotencyKey records the response of a mutating request so a client retry
+// with the same Idempotency-Key header replays it instead of running again.
+// StatusCode stays zero while the original request is still being processed.
+// Keys belong to a user, or to the cart of a guest; exactly one is set.
+type IdempotencyKey struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	UserID       *uint     `json:"user_id"`
+	CartID       *uint     `json:"cart_id"`
+	Key          string    `json:"key" gorm:"not null"`
+	RequestHash  string    `json:"request_hash" gorm:"not null"`
+	StatusCode   int       `json:"status_code"`
+	ContentType  string    `json:"content_type"`
+	ResponseBody []byte    `json:"-"`
+	ExpiresAt    time.Time `json:"expires_at" gorm:"not null"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+
+	// Relationships
+	User *User `json:"-"`
+	Cart *Cart `json:"-"`
+}
+
+// IdempotencyOwner is who an idempotency key belongs to: a user, or a guest
+// known by their cart. Exactly one of the IDs is set.
+type IdempotencyOwner struct {
+	UserID *uint
+	CartID *uint
+}
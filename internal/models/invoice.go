@@ -0,0 +1,26 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Invoice is the tax invoice issued for an order when it is confirmed.
+// SequenceNumber runs without gaps across all invoices. FilePath is where the
+// rendered PDF is stored once it has been generated, so later downloads
+// return the same document.
+type Invoice struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	OrderID        uint      `json:"order_id" gorm:"not null;uniqueIndex"`
+	SequenceNumber int64     `json:"sequence_number" gorm:"not null;uniqueIndex"`
+	Number         string    `json:"number" gorm:"not null;uniqueIndex"`
+	IssuedAt       time.Time `json:"issued_at" gorm:"not null"`
+	FilePath       string    `json:"-"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// InvoiceNumber formats a sequence number as printed on the invoice, e.g. INV-000042.
+func InvoiceNumber(sequenceNumber int64) string {
+	return fmt.Sprintf("INV-%06d", sequenceNumber)
+}
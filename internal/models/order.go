@@ -1,23 +1,46 @@
 package models
 
 import (
+	"slices"
 	"time"
 
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
 	"gorm.io/gorm"
 )
 
 type Order struct {
-	ID          uint           `json:"id" gorm:"primaryKey"`
-	UserID      uint           `json:"user_id" gorm:"not null"`
-	Status      OrderStatus    `json:"status" gorm:"default:pending"`
-	TotalAmount float64        `json:"total_amount" gorm:"not null"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+	ID                   uint           `json:"id" gorm:"primaryKey"`
+	UserID               *uint          `json:"user_id"`
+	GuestEmail           *string        `json:"guest_email"`
+	GuestAccessTokenHash *string        `json:"-"`
+	Status               OrderStatus    `json:"status" gorm:"default:pending"`
+	SubtotalAmount       money.Money    `json:"subtotal_amount" gorm:"not null"`
+	DiscountAmount       money.Money    `json:"discount_amount" gorm:"not null"`
+	CouponCode           string         `json:"coupon_code"`
+	ShippingMethodID     *uint          `json:"shipping_method_id"`
+	ShippingMethodName   string         `json:"shipping_method_name"`
+	ShippingAmount       money.Money    `json:"shipping_amount" gorm:"not null"`
+	TaxAmount            money.Money    `json:"tax_amount" gorm:"not null"`
+	TotalAmount          money.Money    `json:"total_amount" gorm:"not null"`
+	RefundedAmount       money.Money    `json:"refunded_amount" gorm:"not null"`
+	PricesIncludeTax     bool           `json:"prices_include_tax" gorm:"default:false"`
+	ConfirmedAt          *time.Time     `json:"confirmed_at"`
+	ShippedAt            *time.Time     `json:"shipped_at"`
+	DeliveredAt          *time.Time     `json:"delivered_at"`
+	CancelledAt          *time.Time     `json:"cancelled_at"`
+	CancellationReason   string         `json:"cancellation_reason"`
+	ShippingAddress      OrderAddress   `json:"shipping_address" gorm:"embedded;embeddedPrefix:shipping_"`
+	BillingAddress       OrderAddress   `json:"billing_address" gorm:"embedded;embeddedPrefix:billing_"`
+	CreatedAt            time.Time      `json:"created_at"`
+	UpdatedAt            time.Time      `json:"updated_at"`
+	DeletedAt            gorm.DeletedAt `json:"-" gorm:"index"`
 
 	// Relationships
 	User       User        `json:"user"`
 	OrderItems []OrderItem `json:"order_items"`
+	Payments   []Payment   `json:"payments"`
+	Shipments  []Shipment  `json:"shipments"`
+	Invoice    *Invoice    `json:"invoice"`
 }
 
 type OrderStatus string
@@ -30,41 +53,159 @@ const (
 	OrderStatusCancelled OrderStatus = "cancelled"
 )
 
+// orderStatusTransitions lists the statuses an order may move to from each status.
+// Delivered and cancelled orders are final.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:   {OrderStatusConfirmed, OrderStatusCancelled},
+	OrderStatusConfirmed: {OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusShipped:   {OrderStatusDelivered},
+}
+
+// CanTransitionTo reports whether an order in status s may move to next.
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	return slices.Contains(orderStatusTransitions[s], next)
+}
+
+// MarkStatus moves the order to status and stamps the matching transition time.
+func (o *Order) MarkStatus(status OrderStatus, at time.Time) {
+	o.Status = status
+	switch status {
+	case OrderStatusConfirmed:
+		o.ConfirmedAt = &at
+	case OrderStatusShipped:
+		o.ShippedAt = &at
+	case OrderStatusDelivered:
+		o.DeliveredAt = &at
+	case OrderStatusCancelled:
+		o.CancelledAt = &at
+	}
+}
+
+// OrderItem keeps a snapshot of the product as it was at checkout so later
+// renames, repricing or deletion of the product don't rewrite order history.
+// For a variant, ProductSKU is the variant's SKU and VariantName names its
+// option values.
 type OrderItem struct {
-	ID        uint           `json:"id" gorm:"primaryKey"`
-	OrderID   uint           `json:"order_id" gorm:"not null"`
-	ProductID uint           `json:"product_id" gorm:"not null"`
-	Quantity  int            `json:"quantity" gorm:"not null"`
-	Price     float64        `json:"price" gorm:"not null"`
-	CreatedAt time.Time      `json:"created_at"`
-	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	OrderID     uint           `json:"order_id" gorm:"not null"`
+	ProductID   uint           `json:"product_id" gorm:"not null"`
+	VariantID   *uint          `json:"variant_id"`
+	Quantity    int            `json:"quantity" gorm:"not null"`
+	Price       money.Money    `json:"price" gorm:"not null"`
+	ProductName string         `json:"product_name" gorm:"not null"`
+	VariantName string         `json:"variant_name"`
+	ProductSKU  string         `json:"product_sku" gorm:"not null"`
+	UnitPrice   money.Money    `json:"unit_price" gorm:"not null"`
+	LineTotal   money.Money    `json:"line_total" gorm:"not null"`
+	ImageURL    string         `json:"image_url"`
+	CreatedAt   time.Time      `json:"created_at"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Discount and tax on this line. TaxRateBasisPoints is the rate in
+	// hundredths of a percent that was applied.
+	DiscountAmount     money.Money `json:"discount_amount" gorm:"not null"`
+	TaxAmount          money.Money `json:"tax_amount" gorm:"not null"`
+	TaxRateBasisPoints int         `json:"tax_rate_basis_points" gorm:"default:0"`
 
 	// Relationships
 	Order   Order   `json:"-"`
 	Product Product `json:"product"`
 }
 
+// DisplayName is the product's name followed by the variant's when the item
+// is a variant, e.g. "T-shirt (M / Red)".
+func (i *OrderItem) DisplayName() string {
+	if i.VariantName == "" {
+		return i.ProductName
+	}
+	return i.ProductName + " (" + i.VariantName + ")"
+}
+
+// Line returns the cart line the order item was bought from.
+func (i *OrderItem) Line() CartLine {
+	return newCartLine(i.ProductID, i.VariantID)
+}
+
+// PaidAmount returns what the customer paid for quantity units of the order
+// item: its share of the line after discount, plus tax when prices exclude it.
+func (i *OrderItem) PaidAmount(quantity int, pricesIncludeTax bool) money.Money {
+	paid := i.LineTotal.Sub(i.DiscountAmount)
+	if !pricesIncludeTax {
+		paid = paid.Add(i.TaxAmount)
+	}
+	if quantity == i.Quantity {
+		return paid
+	}
+	return paid.Mul(quantity).Div(i.Quantity)
+}
+
 type Cart struct {
 	ID        uint           `json:"id" gorm:"primaryKey"`
-	UserID    uint           `json:"user_id" gorm:"uniqueIndex;not null"`
+	UserID    *uint          `json:"user_id" gorm:"uniqueIndex"`
+	CouponID  *uint          `json:"coupon_id"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
 
 	// Relationships
 	CartItems []CartItem `json:"cart_items"`
+	Coupon    *Coupon    `json:"coupon"`
+}
+
+// CartMergeLine records how a guest cart item was merged into a user's cart.
+// Unavailable is set when the product is no longer sold, and AddedQuantity
+// falls short of RequestedQuantity when there wasn't enough stock.
+type CartMergeLine struct {
+	ProductID         uint
+	ProductName       string
+	RequestedQuantity int
+	AddedQuantity     int
+	Unavailable       bool
 }
 
+// CartItem is a quantity of a product in a cart. Products sold as variants
+// are added as one of their variants, which is set in VariantID.
 type CartItem struct {
 	ID        uint           `json:"id" gorm:"primaryKey"`
 	CartID    uint           `json:"cart_id" gorm:"not null"`
 	ProductID uint           `json:"product_id" gorm:"not null"`
+	VariantID *uint          `json:"variant_id"`
 	Quantity  int            `json:"quantity" gorm:"not null"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
 
 	// Relationships
-	Cart    Cart    `json:"-"`
-	Product Product `json:"product"`
+	Cart    Cart            `json:"-"`
+	Product Product         `json:"product"`
+	Variant *ProductVariant `json:"variant"`
+}
+
+// UnitPrice is the price of one of the cart item: its variant's price when
+// the variant overrides it, else the product's. The variant must be loaded.
+func (i *CartItem) UnitPrice() money.Money {
+	if i.Variant != nil {
+		return i.Variant.PriceOr(i.Product.Price)
+	}
+	return i.Product.Price
+}
+
+// CartLine identifies what a cart item holds: a product, or one of its
+// variants. A cart has at most one item per line.
+type CartLine struct {
+	ProductID uint
+	VariantID uint
+}
+
+func newCartLine(productID uint, variantID *uint) CartLine {
+	line := CartLine{ProductID: productID}
+	if variantID != nil {
+		line.VariantID = *variantID
+	}
+	return line
+}
+
+// Line returns the cart line the item holds.
+func (i *CartItem) Line() CartLine {
+	return newCartLine(i.ProductID, i.VariantID)
 }
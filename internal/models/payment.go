@@ -0,0 +1,60 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// Payment records a charge against an order at the payment provider.
+type Payment struct {
+	ID            uint          `json:"id" gorm:"primaryKey"`
+	OrderID       uint          `json:"order_id" gorm:"not null"`
+	Provider      string        `json:"provider" gorm:"not null"`
+	TransactionID string        `json:"transaction_id" gorm:"not null"`
+	Status        PaymentStatus `json:"status" gorm:"not null"`
+	Amount        money.Money   `json:"amount" gorm:"not null"`
+	FailureReason string        `json:"failure_reason"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+
+	// Relationships
+	Order Order `json:"-"`
+}
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending    PaymentStatus = "pending"
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	PaymentStatusCaptured   PaymentStatus = "captured"
+	PaymentStatusRefunded   PaymentStatus = "refunded"
+	PaymentStatusVoided     PaymentStatus = "voided"
+	PaymentStatusFailed     PaymentStatus = "failed"
+)
+
+// paymentStatusTransitions lists the statuses a payment may move to from each
+// status. A pending payment may be captured straight away by providers that
+// capture on authorization. Refunded, voided and failed payments are final.
+var paymentStatusTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusPending:    {PaymentStatusAuthorized, PaymentStatusCaptured, PaymentStatusVoided, PaymentStatusFailed},
+	PaymentStatusAuthorized: {PaymentStatusCaptured, PaymentStatusVoided, PaymentStatusFailed},
+	PaymentStatusCaptured:   {PaymentStatusRefunded},
+}
+
+// CanTransitionTo reports whether a payment in status s may move to next.
+func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	return slices.Contains(paymentStatusTransitions[s], next)
+}
+
+// PaymentStatusesBefore returns the statuses a payment may move to status from.
+func PaymentStatusesBefore(status PaymentStatus) []PaymentStatus {
+	var previous []PaymentStatus
+	for from, to := range paymentStatusTransitions {
+		if slices.Contains(to, status) {
+			previous = append(previous, from)
+		}
+	}
+	return previous
+}
@@ -3,12 +3,17 @@ package models
 import (
 	"time"
 
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
 	"gorm.io/gorm"
 )
 
+// Category groups products. Categories form a tree: ParentID is nil for
+// top-level categories.
 type Category struct {
 	ID          uint           `json:"id" gorm:"primaryKey"`
+	ParentID    *uint          `json:"parent_id"`
 	Name        string         `json:"name" gorm:"not null"`
+	Slug        string         `json:"slug" gorm:"not null"`
 	Description string         `json:"description"`
 	IsActive    bool           `json:"is_active" gorm:"default:true"`
 	CreatedAt   time.Time      `json:"created_at"`
@@ -16,7 +21,9 @@ type Category struct {
 	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
 
 	// Relationships
-	Products []Product `json:"-"`
+	Parent   *Category  `json:"-"`
+	Children []Category `json:"-" gorm:"foreignKey:ParentID"`
+	Products []Product  `json:"-"`
 }
 
 type Product struct {
@@ -24,24 +31,52 @@ type Product struct {
 	CategoryID  uint           `json:"category_id" gorm:"not null"`
 	Name        string         `json:"name" gorm:"not null"`
 	Description string         `json:"description"`
-	Price       float64        `json:"price" gorm:"not null"`
+	Price       money.Money    `json:"price" gorm:"not null"`
 	Stock       int            `json:"stock" gorm:"default:0"`
 	SKU         string         `json:"sku" gorm:"uniqueIndex;not null"`
+	TaxClass    string         `json:"tax_class" gorm:"default:standard"`
+	WeightGrams int            `json:"weight_grams" gorm:"default:0"`
 	IsActive    bool           `json:"is_active" gorm:"default:true"`
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
 
+	// ReservedStock is the stock held by active reservations. It is only
+	// loaded by queries that select it and is never written.
+	ReservedStock int `json:"-" gorm:"->"`
+
 	// Relationships
-	Category   Category       `json:"category"`
-	Images     []ProductImage `json:"images"`
-	OrderItems []OrderItem    `json:"-"`
-	CartItems  []CartItem     `json:"-"`
+	Category   Category         `json:"category"`
+	Images     []ProductImage   `json:"images"`
+	Options    []ProductOption  `json:"options"`
+	Variants   []ProductVariant `json:"variants"`
+	OrderItems []OrderItem      `json:"-"`
+	CartItems  []CartItem       `json:"-"`
+}
+
+// AvailableStock is the stock that can still be sold: what is in stock less
+// what is reserved for carts being checked out.
+func (p *Product) AvailableStock() int {
+	return max(p.Stock-p.ReservedStock, 0)
+}
+
+// PrimaryImageURL returns the URL of the product's primary image, or an empty
+// string when the images weren't loaded or none is marked primary.
+func (p *Product) PrimaryImageURL() string {
+	for i := range p.Images {
+		if p.Images[i].IsPrimary {
+			return p.Images[i].URL
+		}
+	}
+	return ""
 }
 
+// ProductImage is a picture of a product. Pictures of one of its variants
+// also have the VariantID set.
 type ProductImage struct {
 	ID        uint           `json:"id" gorm:"primaryKey"`
 	ProductID uint           `json:"product_id" gorm:"not null"`
+	VariantID *uint          `json:"variant_id"`
 	URL       string         `json:"url" gorm:"not null"`
 	AltText   string         `json:"alt_text"`
 	IsPrimary bool           `json:"is_primary" gorm:"default:false"`
@@ -56,3 +91,48 @@ type ProductsWithRank struct {
 	Product
 	Rank float32 `gorm:"column:rank"`
 }
+
+// ProductFacets counts the products matching a search by the values the
+// search can be narrowed down by. Total applies all of the search's filters;
+// each facet applies all but its own, so it shows what choosing another
+// value would match.
+type ProductFacets struct {
+	Total       int64
+	Categories  []CategoryFacet
+	PriceRanges []PriceRangeFacet
+	InStock     int64
+	OutOfStock  int64
+	Attributes  []AttributeFacet
+}
+
+type CategoryFacet struct {
+	CategoryID uint
+	Name       string
+	Count      int64
+}
+
+// PriceRangeFacet counts the products priced from Min up to but not
+// including Max. The last range has no Max.
+type PriceRangeFacet struct {
+	Min   money.Money
+	Max   *money.Money
+	Count int64
+}
+
+// AttributeFacet counts the products with an active variant that has Value
+// for the option named Option.
+type AttributeFacet struct {
+	Option string
+	Value  string
+	Count  int64
+}
+
+// SearchSuggestion is a product or category whose name completes or
+// resembles a search query. Kind is "product" or "category", and Slug is
+// only set for categories.
+type SearchSuggestion struct {
+	Kind string
+	ID   uint
+	Name string
+	Slug string
+}
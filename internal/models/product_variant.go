@@ -0,0 +1,117 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"gorm.io/gorm"
+)
+
+// ProductOption is a way a product comes in, such as size or colour, with
+// the values it can take.
+type ProductOption struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ProductID uint      `json:"product_id" gorm:"not null"`
+	Name      string    `json:"name" gorm:"not null"`
+	Position  int       `json:"position" gorm:"default:0"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Relationships
+	Values []ProductOptionValue `json:"values" gorm:"foreignKey:OptionID"`
+}
+
+type ProductOptionValue struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	OptionID uint   `json:"option_id" gorm:"not null"`
+	Value    string `json:"value" gorm:"not null"`
+	Position int    `json:"position" gorm:"default:0"`
+}
+
+// ProductVariant is one combination of a product's option values, sold with
+// its own SKU and stock. Price overrides the product's price when it is set.
+type ProductVariant struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	ProductID uint           `json:"product_id" gorm:"not null"`
+	SKU       string         `json:"sku" gorm:"uniqueIndex;not null"`
+	Price     *money.Money   `json:"price"`
+	Stock     int            `json:"stock" gorm:"default:0"`
+	IsActive  bool           `json:"is_active" gorm:"default:true"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// ReservedStock is the stock held by active reservations. It is only
+	// loaded by queries that select it and is never written.
+	ReservedStock int `json:"-" gorm:"->"`
+
+	// Relationships
+	Product      Product              `json:"-"`
+	OptionValues []ProductOptionValue `json:"option_values" gorm:"many2many:product_variant_option_values;joinForeignKey:VariantID;joinReferences:OptionValueID"`
+}
+
+// AvailableStock is the stock of the variant that can still be sold.
+func (v *ProductVariant) AvailableStock() int {
+	return max(v.Stock-v.ReservedStock, 0)
+}
+
+// PriceOr returns the variant's price, or price when it doesn't override it.
+func (v *ProductVariant) PriceOr(price money.Money) money.Money {
+	if v.Price != nil {
+		return *v.Price
+	}
+	return price
+}
+
+// HasVariants reports whether the product is sold as variants, in which case
+// its own stock isn't used. The variants must be loaded.
+func (p *Product) HasVariants() bool {
+	return len(p.Variants) > 0
+}
+
+// Variant returns the loaded variant of the product with id, or nil.
+func (p *Product) Variant(id uint) *ProductVariant {
+	for i := range p.Variants {
+		if p.Variants[i].ID == id {
+			return &p.Variants[i]
+		}
+	}
+	return nil
+}
+
+// VariantName describes a variant by its option values in the order of the
+// product's options, e.g. "M / Red". The options must be loaded.
+func (p *Product) VariantName(variant *ProductVariant) string {
+	var names []string
+	for i := range p.Options {
+		for j := range variant.OptionValues {
+			if variant.OptionValues[j].OptionID == p.Options[i].ID {
+				names = append(names, variant.OptionValues[j].Value)
+			}
+		}
+	}
+	return strings.Join(names, " / ")
+}
+
+// VariantImageURL returns the URL of the variant's first image, falling back
+// to the product's primary image.
+func (p *Product) VariantImageURL(variantID uint) string {
+	index := slices.IndexFunc(p.Images, func(image ProductImage) bool {
+		return image.VariantID != nil && *image.VariantID == variantID
+	})
+	if index >= 0 {
+		return p.Images[index].URL
+	}
+	return p.PrimaryImageURL()
+}
+
+// DisplayName is the product's name followed by the variant's when there is
+// one, e.g. "T-shirt (M / Red)". The options must be loaded.
+func (p *Product) DisplayName(variant *ProductVariant) string {
+	if variant == nil {
+		return p.Name
+	}
+	return p.Name + " (" + p.VariantName(variant) + ")"
+}
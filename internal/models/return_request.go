@@ -0,0 +1,91 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"gorm.io/gorm"
+)
+
+// ReturnRequest is a customer's request to send back items of a delivered
+// order. RefundAmount is set once the return is refunded.
+type ReturnRequest struct {
+	ID              uint           `json:"id" gorm:"primaryKey"`
+	OrderID         uint           `json:"order_id" gorm:"not null"`
+	UserID          uint           `json:"user_id" gorm:"not null"`
+	Status          ReturnStatus   `json:"status" gorm:"default:requested"`
+	Reason          string         `json:"reason" gorm:"not null"`
+	RejectionReason string         `json:"rejection_reason"`
+	RefundAmount    money.Money    `json:"refund_amount" gorm:"not null"`
+	ApprovedAt      *time.Time     `json:"approved_at"`
+	ReceivedAt      *time.Time     `json:"received_at"`
+	RefundedAt      *time.Time     `json:"refunded_at"`
+	RejectedAt      *time.Time     `json:"rejected_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relationships
+	Order Order        `json:"-"`
+	Items []ReturnItem `json:"items" gorm:"foreignKey:ReturnRequestID"`
+}
+
+// ReturnItem is a quantity of an order item being returned.
+type ReturnItem struct {
+	ID              uint `json:"id" gorm:"primaryKey"`
+	ReturnRequestID uint `json:"return_request_id" gorm:"not null"`
+	OrderItemID     uint `json:"order_item_id" gorm:"not null"`
+	Quantity        int  `json:"quantity" gorm:"not null"`
+
+	// Relationships
+	OrderItem OrderItem `json:"order_item"`
+}
+
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "requested"
+	ReturnStatusApproved  ReturnStatus = "approved"
+	ReturnStatusReceived  ReturnStatus = "received"
+	ReturnStatusRefunded  ReturnStatus = "refunded"
+	ReturnStatusRejected  ReturnStatus = "rejected"
+)
+
+// returnStatusTransitions lists the statuses a return may move to from each
+// status. Refunded and rejected returns are final.
+var returnStatusTransitions = map[ReturnStatus][]ReturnStatus{
+	ReturnStatusRequested: {ReturnStatusApproved, ReturnStatusRejected},
+	ReturnStatusApproved:  {ReturnStatusReceived, ReturnStatusRejected},
+	ReturnStatusReceived:  {ReturnStatusRefunded},
+}
+
+// CanTransitionTo reports whether a return in status s may move to next.
+func (s ReturnStatus) CanTransitionTo(next ReturnStatus) bool {
+	return slices.Contains(returnStatusTransitions[s], next)
+}
+
+// MarkStatus moves the return to status and stamps the matching transition time.
+func (r *ReturnRequest) MarkStatus(status ReturnStatus, at time.Time) {
+	r.Status = status
+	switch status {
+	case ReturnStatusApproved:
+		r.ApprovedAt = &at
+	case ReturnStatusReceived:
+		r.ReceivedAt = &at
+	case ReturnStatusRefunded:
+		r.RefundedAt = &at
+	case ReturnStatusRejected:
+		r.RejectedAt = &at
+	}
+}
+
+// RefundableAmount is the default refund for the return: what was paid for
+// the returned items. The items need their OrderItem loaded.
+func (r *ReturnRequest) RefundableAmount(pricesIncludeTax bool) money.Money {
+	total := money.New(0)
+	for i := range r.Items {
+		total = total.Add(r.Items[i].OrderItem.PaidAmount(r.Items[i].Quantity, pricesIncludeTax))
+	}
+	return total
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// SearchSynonym makes product searches for Term also match Synonym. Terms
+// are single lowercased words, while synonyms may be phrases. A synonym only
+// works one way; searches for Synonym need their own entry to match Term.
+type SearchSynonym struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Term      string    `json:"term" gorm:"not null"`
+	Synonym   string    `json:"synonym" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Shipment is a parcel sent for an order. An order can ship in several
+// shipments, each holding some of its items.
+type Shipment struct {
+	ID             uint           `json:"id" gorm:"primaryKey"`
+	OrderID        uint           `json:"order_id" gorm:"not null"`
+	Carrier        string         `json:"carrier" gorm:"not null"`
+	TrackingNumber string         `json:"tracking_number" gorm:"not null"`
+	Status         ShipmentStatus `json:"status" gorm:"default:shipped"`
+	ShippedAt      time.Time      `json:"shipped_at"`
+	DeliveredAt    *time.Time     `json:"delivered_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+
+	// Relationships
+	Order Order          `json:"-"`
+	Items []ShipmentItem `json:"items"`
+}
+
+// ShipmentItem is a quantity of an order item packed in a shipment.
+type ShipmentItem struct {
+	ID          uint `json:"id" gorm:"primaryKey"`
+	ShipmentID  uint `json:"shipment_id" gorm:"not null"`
+	OrderItemID uint `json:"order_item_id" gorm:"not null"`
+	Quantity    int  `json:"quantity" gorm:"not null"`
+
+	// Relationships
+	OrderItem OrderItem `json:"order_item"`
+}
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusShipped   ShipmentStatus = "shipped"
+	ShipmentStatusDelivered ShipmentStatus = "delivered"
+)
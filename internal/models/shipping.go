@@ -0,0 +1,129 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"gorm.io/gorm"
+)
+
+// ShippingZone groups the destinations that share shipping methods. A zone
+// without locations is the catch-all for destinations no other zone covers.
+type ShippingZone struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	Name      string         `json:"name" gorm:"not null"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relationships
+	Locations []ShippingZoneLocation `json:"locations" gorm:"foreignKey:ZoneID"`
+	Methods   []ShippingMethod       `json:"methods" gorm:"foreignKey:ZoneID"`
+}
+
+// ShippingZoneLocation is a country, or a region of it when Region is set,
+// covered by a zone.
+type ShippingZoneLocation struct {
+	ID      uint   `json:"id" gorm:"primaryKey"`
+	ZoneID  uint   `json:"zone_id" gorm:"not null"`
+	Country string `json:"country" gorm:"not null"`
+	Region  string `json:"region"`
+}
+
+type ShippingMethodType string
+
+const (
+	ShippingMethodFlatRate          ShippingMethodType = "flat_rate"
+	ShippingMethodWeightBased       ShippingMethodType = "weight_based"
+	ShippingMethodFreeOverThreshold ShippingMethodType = "free_over_threshold"
+)
+
+// ShippingMethod is a way of shipping to a zone. Rate is the flat price, or
+// the base price of weight based methods, which add PerKgRate for every
+// started kilogram. Free over threshold methods charge Rate unless the order
+// reaches FreeThreshold.
+type ShippingMethod struct {
+	ID            uint               `json:"id" gorm:"primaryKey"`
+	ZoneID        uint               `json:"zone_id" gorm:"not null"`
+	Name          string             `json:"name" gorm:"not null"`
+	Type          ShippingMethodType `json:"type" gorm:"not null"`
+	Rate          money.Money        `json:"rate" gorm:"not null"`
+	PerKgRate     money.Money        `json:"per_kg_rate" gorm:"not null"`
+	FreeThreshold money.Money        `json:"free_threshold" gorm:"not null"`
+	IsActive      bool               `json:"is_active" gorm:"default:true"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt     `json:"-" gorm:"index"`
+
+	// Relationships
+	Zone ShippingZone `json:"-"`
+}
+
+const gramsPerKg = 1000
+
+// Quote returns the cost of shipping goods worth orderValue and weighing
+// weightGrams with the method.
+func (m *ShippingMethod) Quote(orderValue money.Money, weightGrams int) money.Money {
+	switch m.Type {
+	case ShippingMethodWeightBased:
+		kilograms := (weightGrams + gramsPerKg - 1) / gramsPerKg
+		return m.Rate.Add(m.PerKgRate.Mul(kilograms))
+	case ShippingMethodFreeOverThreshold:
+		if orderValue.Cmp(m.FreeThreshold) >= 0 {
+			return money.New(0)
+		}
+		return m.Rate
+	default:
+		return m.Rate
+	}
+}
+
+// MatchScore reports how closely the zone covers a destination: 2 for a
+// region match, 1 for a whole-country match or the catch-all zone's 0. It
+// returns -1 when the zone doesn't cover the destination.
+func (z *ShippingZone) MatchScore(country, region string) int {
+	if len(z.Locations) == 0 {
+		return 0
+	}
+
+	score := -1
+	for _, location := range z.Locations {
+		if !strings.EqualFold(location.Country, country) {
+			continue
+		}
+
+		switch {
+		case location.Region != "" && strings.EqualFold(location.Region, region):
+			return 2
+		case location.Region == "":
+			score = 1
+		}
+	}
+	return score
+}
+
+// MatchShippingZone picks the zone that covers a destination most closely, or
+// nil when none does.
+func MatchShippingZone(zones []ShippingZone, country, region string) *ShippingZone {
+	var (
+		best      *ShippingZone
+		bestScore = -1
+	)
+	for i := range zones {
+		if score := zones[i].MatchScore(country, region); score > bestScore {
+			best, bestScore = &zones[i], score
+		}
+	}
+	return best
+}
+
+// ShippingWeight returns the total weight in grams of the given cart items.
+// The cart items need their Product loaded.
+func ShippingWeight(items []CartItem) int {
+	weight := 0
+	for i := range items {
+		weight += items[i].Product.WeightGrams * items[i].Quantity
+	}
+	return weight
+}
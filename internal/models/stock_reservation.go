@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// StockReservation holds stock of a product for a cart while the cart is
+// checked out, so it can't be sold to anyone else until ExpiresAt.
+type StockReservation struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	CartID    uint      `json:"cart_id" gorm:"not null"`
+	ProductID uint      `json:"product_id" gorm:"not null"`
+	VariantID *uint     `json:"variant_id"`
+	Quantity  int       `json:"quantity" gorm:"not null"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Relationships
+	Product Product         `json:"product"`
+	Variant *ProductVariant `json:"variant"`
+}
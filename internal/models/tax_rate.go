@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// TaxRate is the rate charged for a tax class in a country, or in a region of
+// it when Region is set. BasisPoints is the rate in hundredths of a percent.
+type TaxRate struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	Country     string    `json:"country" gorm:"not null"`
+	Region      string    `json:"region"`
+	TaxClass    string    `json:"tax_class" gorm:"not null"`
+	Name        string    `json:"name"`
+	BasisPoints int       `json:"basis_points" gorm:"not null"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
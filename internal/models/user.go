@@ -22,6 +22,7 @@ type User struct {
 	// Relationships
 	RefreshTokens []RefreshToken `json:"-"`
 	Orders        []Order        `json:"-"`
+	Addresses     []Address      `json:"-"`
 	Cart          Cart           `json:"-"`
 }
 
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Wishlist is a named list of products a user saved for later. Anyone with
+// the ShareToken can view the list; it is nil while the list isn't shared.
+type Wishlist struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	UserID     uint      `json:"user_id" gorm:"not null"`
+	Name       string    `json:"name" gorm:"not null"`
+	ShareToken *string   `json:"share_token" gorm:"uniqueIndex"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// Relationships
+	User  User           `json:"-"`
+	Items []WishlistItem `json:"items"`
+}
+
+type WishlistItem struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	WishlistID uint      `json:"wishlist_id" gorm:"not null"`
+	ProductID  uint      `json:"product_id" gorm:"not null"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Relationships
+	Wishlist Wishlist `json:"-"`
+	Product  Product  `json:"product"`
+}
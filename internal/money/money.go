@@ -0,0 +1,335 @@
+// Package money provides an exact monetary amount type stored in minor units.
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the ISO 4217 code used for amounts read from the database,
+// where only the numeric value is stored.
+const DefaultCurrency = "USD"
+
+// minorUnits is the number of minor units in one major unit. All amounts are
+// kept with two decimal places to match the DECIMAL(10,2) columns.
+const minorUnits = 100
+
+var ErrInvalidAmount = errors.New("invalid money amount")
+
+// Money is an amount of Currency held as an integer number of minor units
+// (cents), so arithmetic never drifts the way float64 does.
+//
+// Decimal input with more than two fractional digits is rounded half away
+// from zero, the same rule used everywhere an amount is derived.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// New returns an amount of minor units in the default currency.
+func New(minor int64) Money {
+	return Money{Amount: minor, Currency: DefaultCurrency}
+}
+
+// Parse reads a decimal string such as "12.5" or "-0.125" in the default currency.
+func Parse(s string) (Money, error) {
+	minor, err := parseMinor(s)
+	if err != nil {
+		return Money{}, err
+	}
+	return New(minor), nil
+}
+
+// MustParse is like Parse but panics on invalid input. It is intended for constants.
+func MustParse(s string) Money {
+	m, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Add returns m + other.
+func (m Money) Add(other Money) Money {
+	return Money{Amount: m.Amount + other.Amount, Currency: m.currencyWith(other)}
+}
+
+// Sub returns m - other.
+func (m Money) Sub(other Money) Money {
+	return Money{Amount: m.Amount - other.Amount, Currency: m.currencyWith(other)}
+}
+
+// Mul returns m multiplied by a whole quantity.
+func (m Money) Mul(quantity int) Money {
+	return Money{Amount: m.Amount * int64(quantity), Currency: m.currency()}
+}
+
+// Div returns m divided by a whole quantity, rounded half away from zero.
+func (m Money) Div(quantity int) Money {
+	return Money{Amount: divRound(m.Amount, int64(quantity)), Currency: m.currency()}
+}
+
+// Cmp compares m and other and returns -1, 0 or +1.
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.Amount < other.Amount:
+		return -1
+	case m.Amount > other.Amount:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsPositive reports whether the amount is greater than zero.
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// IsNegative reports whether the amount is less than zero.
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Decimal formats the amount with two decimal places, e.g. "12.50".
+func (m Money) Decimal() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/minorUnits, amount%minorUnits)
+}
+
+// String formats the amount followed by its currency, e.g. "12.50 USD".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.currency()
+}
+
+// Value implements driver.Valuer. Only the decimal amount is stored.
+func (m Money) Value() (driver.Value, error) {
+	return m.Decimal(), nil
+}
+
+// Scan implements sql.Scanner for NUMERIC/DECIMAL columns.
+func (m *Money) Scan(src any) error {
+	var (
+		minor int64
+		err   error
+	)
+	switch v := src.(type) {
+	case nil:
+		minor = 0
+	case string:
+		minor, err = parseMinor(v)
+	case []byte:
+		minor, err = parseMinor(string(v))
+	case int64:
+		minor = v * minorUnits
+	case float64:
+		minor, err = parseMinor(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+	if err != nil {
+		return err
+	}
+
+	*m = New(minor)
+	return nil
+}
+
+// JSON is the wire form of Money in REST and GraphQL responses.
+type JSON struct {
+	Amount   string `json:"amount" example:"12.50"`
+	Currency string `json:"currency" example:"USD"`
+}
+
+// MarshalJSON encodes the amount as {"amount":"12.50","currency":"USD"}.
+// The amount is a string so clients never have to round-trip it through a float.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(JSON{Amount: m.Decimal(), Currency: m.currency()})
+}
+
+// UnmarshalJSON accepts a JSON number, a decimal string or the object form
+// produced by MarshalJSON.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	raw := strings.TrimSpace(string(data))
+	if raw == "null" {
+		return nil
+	}
+
+	if strings.HasPrefix(raw, "{") {
+		var v JSON
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		return m.set(v.Amount, v.Currency)
+	}
+
+	if strings.HasPrefix(raw, `"`) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return m.set(s, "")
+	}
+
+	return m.set(raw, "")
+}
+
+// UnmarshalParam implements gin's binding.BindUnmarshaler for query and form values.
+func (m *Money) UnmarshalParam(param string) error {
+	return m.set(param, "")
+}
+
+// MarshalGQL implements graphql.Marshaler for the Money scalar.
+func (m Money) MarshalGQL(w io.Writer) {
+	data, _ := m.MarshalJSON()
+	_, _ = w.Write(data)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler for the Money scalar.
+func (m *Money) UnmarshalGQL(v any) error {
+	switch v := v.(type) {
+	case string:
+		return m.set(v, "")
+	case json.Number:
+		return m.set(v.String(), "")
+	case int:
+		return m.set(strconv.Itoa(v), "")
+	case int64:
+		return m.set(strconv.FormatInt(v, 10), "")
+	case float64:
+		return m.set(strconv.FormatFloat(v, 'f', -1, 64), "")
+	case map[string]any:
+		amount, _ := v["amount"].(string)
+		currency, _ := v["currency"].(string)
+		return m.set(amount, currency)
+	default:
+		return fmt.Errorf("money: cannot unmarshal %T", v)
+	}
+}
+
+func (m *Money) set(amount, currency string) error {
+	minor, err := parseMinor(amount)
+	if err != nil {
+		return err
+	}
+
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if currency != DefaultCurrency {
+		return fmt.Errorf("money: unsupported currency %q", currency)
+	}
+
+	*m = Money{Amount: minor, Currency: currency}
+	return nil
+}
+
+func (m Money) currency() string {
+	if m.Currency == "" {
+		return DefaultCurrency
+	}
+	return m.Currency
+}
+
+func (m Money) currencyWith(other Money) string {
+	if m.Currency == "" {
+		return other.currency()
+	}
+	return m.Currency
+}
+
+// parseMinor converts a decimal string into minor units, rounding any digits
+// past the second decimal place half away from zero.
+func parseMinor(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return 0, ErrInvalidAmount
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	if !isDigits(whole) || !isDigits(fraction) {
+		return 0, ErrInvalidAmount
+	}
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidAmount
+	}
+
+	fraction += "000"
+	cents, _ := strconv.ParseInt(fraction[:2], 10, 64)
+	minor := units*minorUnits + cents
+	if fraction[2] >= '5' {
+		minor++
+	}
+
+	if negative {
+		minor = -minor
+	}
+	return minor, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// divRound divides a by b rounding half away from zero.
+func divRound(a, b int64) int64 {
+	if b == 0 {
+		return 0
+	}
+	q, r := a/b, a%b
+	if r < 0 {
+		r = -r
+	}
+	if 2*r >= abs(b) {
+		if (a < 0) != (b < 0) {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -1,5 +1,7 @@
 package notifications
 
 const (
-	UserLoggedIn = "USER_LOGGED_IN"
+	UserLoggedIn        = "USER_LOGGED_IN"
+	OrderStatusChanged  = "ORDER_STATUS_CHANGED"
+	ReturnStatusChanged = "RETURN_STATUS_CHANGED"
 )
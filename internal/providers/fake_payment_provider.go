@@ -0,0 +1,120 @@
+package providers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/interfaces"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// Payment tokens understood by FakePaymentProvider. Any other token,
+// including an empty one, is authorized immediately.
+const (
+	FakePaymentTokenDecline = "tok_decline"
+	FakePaymentTokenPending = "tok_pending"
+)
+
+const fakeTransactionPrefix = "fake_auth_"
+
+// FakePaymentProvider is an in-process gateway for local development. Its
+// results depend only on the request, so the same checkout always behaves the
+// same way: tok_decline fails, tok_pending waits for a webhook and everything
+// else is authorized.
+//
+// Webhooks are signed with the hex HMAC-SHA256 of the raw body using the
+// configured secret, and carry {"transaction_id", "status", "failure_reason"}.
+type FakePaymentProvider struct {
+	webhookSecret string
+	log           zerolog.Logger
+}
+
+func NewFakePaymentProvider(webhookSecret string, log zerolog.Logger) *FakePaymentProvider {
+	return &FakePaymentProvider{webhookSecret: webhookSecret, log: log}
+}
+
+func (p *FakePaymentProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakePaymentProvider) Authorize(req interfaces.PaymentRequest) (*interfaces.PaymentResult, error) {
+	if !req.Amount.IsPositive() {
+		return nil, errors.New("payment amount must be greater than zero")
+	}
+
+	result := &interfaces.PaymentResult{
+		TransactionID: fmt.Sprintf("%s%d", fakeTransactionPrefix, req.OrderID),
+		Status:        models.PaymentStatusAuthorized,
+	}
+
+	switch req.Token {
+	case FakePaymentTokenDecline:
+		result.Status = models.PaymentStatusFailed
+		result.FailureReason = "card declined"
+	case FakePaymentTokenPending:
+		result.Status = models.PaymentStatusPending
+	}
+
+	p.log.Info().Str("transaction_id", result.TransactionID).Str("status", string(result.Status)).Msg("fake payment authorized")
+	return result, nil
+}
+
+func (p *FakePaymentProvider) Capture(transactionID string, amount money.Money) (*interfaces.PaymentResult, error) {
+	return p.settle(transactionID, models.PaymentStatusCaptured)
+}
+
+func (p *FakePaymentProvider) Refund(transactionID string, amount money.Money) (*interfaces.PaymentResult, error) {
+	return p.settle(transactionID, models.PaymentStatusRefunded)
+}
+
+func (p *FakePaymentProvider) Void(transactionID string) (*interfaces.PaymentResult, error) {
+	return p.settle(transactionID, models.PaymentStatusVoided)
+}
+
+func (p *FakePaymentProvider) ParseWebhook(payload []byte, signature string) (*interfaces.PaymentResult, error) {
+	mac := hmac.New(sha256.New, []byte(p.webhookSecret))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, interfaces.ErrInvalidWebhookSignature
+	}
+
+	var event struct {
+		TransactionID string `json:"transaction_id"`
+		Status        string `json:"status"`
+		FailureReason string `json:"failure_reason"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	status := models.PaymentStatus(event.Status)
+	switch status {
+	case models.PaymentStatusAuthorized, models.PaymentStatusCaptured, models.PaymentStatusRefunded,
+		models.PaymentStatusVoided, models.PaymentStatusFailed:
+	default:
+		return nil, fmt.Errorf("unknown payment status: %s", event.Status)
+	}
+
+	return &interfaces.PaymentResult{
+		TransactionID: event.TransactionID,
+		Status:        status,
+		FailureReason: event.FailureReason,
+	}, nil
+}
+
+func (p *FakePaymentProvider) settle(transactionID string, status models.PaymentStatus) (*interfaces.PaymentResult, error) {
+	if !strings.HasPrefix(transactionID, fakeTransactionPrefix) {
+		return nil, fmt.Errorf("unknown transaction: %s", transactionID)
+	}
+
+	p.log.Info().Str("transaction_id", transactionID).Str("status", string(status)).Msg("fake payment settled")
+	return &interfaces.PaymentResult{TransactionID: transactionID, Status: status}, nil
+}
@@ -2,6 +2,7 @@ package providers
 
 import (
 	"fmt"
+	"io"
 	"mime/multipart"
 	"os"
 	"path/filepath"
@@ -56,6 +57,30 @@ func (p *LocalUploadProvider) UploadFile(file *multipart.FileHeader, path string
 
 }
 
+func (p *LocalUploadProvider) SaveFile(content io.Reader, path, contentType string) error {
+	fullPath := filepath.Join(p.basePath, path)
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		return err
+	}
+
+	dst, err := os.Create(fullPath) // #nosec G304
+	if err != nil {
+		return err
+	}
+
+	if _, err := dst.ReadFrom(content); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	return dst.Close()
+}
+
+func (p *LocalUploadProvider) OpenFile(path string) (io.ReadCloser, error) {
+	fullPath := filepath.Join(p.basePath, path)
+	return os.Open(fullPath) // #nosec G304
+}
+
 func (p *LocalUploadProvider) DeleteFile(path string) error {
 	fullPath := filepath.Join(p.basePath, path)
 	return os.Remove(fullPath)
@@ -2,6 +2,7 @@ package providers
 
 import (
 	"context"
+	"io"
 	"mime/multipart"
 	"strings"
 	"time"
@@ -24,7 +25,8 @@ type S3Provider struct {
 	log      zerolog.Logger
 }
 
-func NewS3Provider(cfg *appconfig.Config, log zerolog.Logger) *S3Provider {
+// NewS3Provider creates a provider storing files in bucket.
+func NewS3Provider(cfg *appconfig.Config, bucket string, log zerolog.Logger) *S3Provider {
 	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithRegion(cfg.AWS.Region),
 		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
@@ -57,7 +59,7 @@ func NewS3Provider(cfg *appconfig.Config, log zerolog.Logger) *S3Provider {
 	return &S3Provider{
 		client:   client,
 		xfer:     xfer,
-		bucket:   cfg.AWS.S3Bucket,
+		bucket:   bucket,
 		endpoint: cfg.AWS.S3Endpoint,
 	}
 }
@@ -103,6 +105,34 @@ func (p *S3Provider) UploadFile(file *multipart.FileHeader, path string) (string
 	return *result.Key, nil
 }
 
+func (p *S3Provider) SaveFile(content io.Reader, path, contentType string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	_, err := p.xfer.UploadObject(ctx, &transfermanager.UploadObjectInput{
+		Bucket:      aws.String(p.bucket),
+		Key:         aws.String(path),
+		Body:        content,
+		ContentType: aws.String(contentType),
+	})
+
+	return err
+}
+
+// OpenFile streams the object at path. The body outlives this call, so no
+// timeout is applied to the request.
+func (p *S3Provider) OpenFile(path string) (io.ReadCloser, error) {
+	result, err := p.client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(p.bucket),
+		Key:    aws.String(strings.TrimPrefix(path, "/")),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Body, nil
+}
+
 func (p *S3Provider) DeleteFile(path string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 	defer cancel()
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+)
+
+var _ AddressRepositoryInterface = (*AddressRepository)(nil)
+
+type AddressRepository struct {
+	db *gorm.DB
+}
+
+func NewAddressRepository(db *gorm.DB) *AddressRepository {
+	return &AddressRepository{db: db}
+}
+
+// GetByUserID implements AddressRepositoryInterface.
+func (r *AddressRepository) GetByUserID(userID uint) ([]models.Address, error) {
+	var addresses []models.Address
+	if err := r.db.Where("user_id = ?", userID).Order("created_at").Find(&addresses).Error; err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// GetByUserIDAndID implements AddressRepositoryInterface.
+func (r *AddressRepository) GetByUserIDAndID(userID, addressID uint) (*models.Address, error) {
+	var address models.Address
+	if err := r.db.Where("id = ? AND user_id = ?", addressID, userID).First(&address).Error; err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// GetDefaultShipping implements AddressRepositoryInterface.
+func (r *AddressRepository) GetDefaultShipping(userID uint) (*models.Address, error) {
+	var address models.Address
+	if err := r.db.Where("user_id = ? AND is_default_shipping = ?", userID, true).First(&address).Error; err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// GetDefaultBilling implements AddressRepositoryInterface.
+func (r *AddressRepository) GetDefaultBilling(userID uint) (*models.Address, error) {
+	var address models.Address
+	if err := r.db.Where("user_id = ? AND is_default_billing = ?", userID, true).First(&address).Error; err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// Create implements AddressRepositoryInterface.
+func (r *AddressRepository) Create(address *models.Address) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := clearDefaultAddresses(tx, address); err != nil {
+			return err
+		}
+		return tx.Create(address).Error
+	})
+}
+
+// Update implements AddressRepositoryInterface.
+func (r *AddressRepository) Update(address *models.Address) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := clearDefaultAddresses(tx, address); err != nil {
+			return err
+		}
+		return tx.Save(address).Error
+	})
+}
+
+// Delete implements AddressRepositoryInterface.
+func (r *AddressRepository) Delete(userID, addressID uint) error {
+	return r.db.Where("id = ? AND user_id = ?", addressID, userID).Delete(&models.Address{}).Error
+}
+
+// clearDefaultAddresses unsets the default flags on the user's other addresses
+// when address is about to become the default, so each user has at most one of each.
+func clearDefaultAddresses(tx *gorm.DB, address *models.Address) error {
+	if address.IsDefaultShipping {
+		if err := tx.Model(&models.Address{}).
+			Where("user_id = ? AND id <> ?", address.UserID, address.ID).
+			Update("is_default_shipping", false).Error; err != nil {
+			return err
+		}
+	}
+
+	if address.IsDefaultBilling {
+		if err := tx.Model(&models.Address{}).
+			Where("user_id = ? AND id <> ?", address.UserID, address.ID).
+			Update("is_default_billing", false).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ CartRepositoryInterface = (*CartRepository)(nil)
@@ -19,11 +20,32 @@ func NewCartRepository(db *gorm.DB) *CartRepository {
 	}
 }
 
+// withCartItems loads the cart items with their products and variants.
+func withCartItems(db *gorm.DB) *gorm.DB {
+	// Called directly, as scopes added from inside a scope aren't run
+	db = withProductDetails("CartItems.Product")(db.Preload("CartItems.Product", withReservedStock))
+	return db.Preload("CartItems.Variant", withVariantReservedStock).Preload("CartItems.Variant.OptionValues")
+}
+
 func (c *CartRepository) GetByUserID(userID uint) (*models.Cart, error) {
 	var cart models.Cart
 
-	if err := c.db.Preload("CartItems.Product.Category").Where("user_id = ?",
-		userID).First(&cart).Error; err != nil {
+	if err := c.db.Scopes(withCartItems).
+		Preload("Coupon.Products").Preload("Coupon.Categories").
+		Where("user_id = ?", userID).First(&cart).Error; err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
+
+// GetGuestCart returns the guest cart with cartID. Carts that belong to a
+// user are never returned.
+func (c *CartRepository) GetGuestCart(cartID uint) (*models.Cart, error) {
+	var cart models.Cart
+
+	if err := c.db.Scopes(withCartItems).
+		Preload("Coupon.Products").Preload("Coupon.Categories").
+		Where("id = ? AND user_id IS NULL", cartID).First(&cart).Error; err != nil {
 		return nil, err
 	}
 	return &cart, nil
@@ -37,13 +59,27 @@ func (c *CartRepository) Update(cart *models.Cart) error {
 	return c.db.Save(&cart).Error
 }
 
+// SetCoupon attaches a coupon to the cart, or removes it when couponID is nil.
+func (c *CartRepository) SetCoupon(cartID uint, couponID *uint) error {
+	return c.db.Model(&models.Cart{}).Where("id = ?", cartID).Update("coupon_id", couponID).Error
+}
+
 func (c *CartRepository) Delete(id uint) error {
 	return c.db.Delete(&models.Cart{}, id).Error
 }
 
-func (c *CartRepository) GetCartItemByCartIDAndProductID(cartID, productID uint) (*models.CartItem, error) {
+// GetCartItemByCartIDAndProductID returns the cart's item for the product,
+// or for the product's variant when variantID is set.
+func (c *CartRepository) GetCartItemByCartIDAndProductID(cartID, productID uint, variantID *uint) (*models.CartItem, error) {
+	query := c.db.Where("cart_id = ? AND product_id = ?", cartID, productID)
+	if variantID != nil {
+		query = query.Where("variant_id = ?", *variantID)
+	} else {
+		query = query.Where("variant_id IS NULL")
+	}
+
 	var cartItem models.CartItem
-	if err := c.db.Where("cart_id = ? AND product_id = ?", cartID, productID).First(&cartItem).Error; err != nil {
+	if err := query.First(&cartItem).Error; err != nil {
 		return nil, err
 	}
 	return &cartItem, nil
@@ -59,6 +95,14 @@ func (c *CartRepository) GetCartItemByCartItemIDAndUserID(cartItemID, userID uin
 	return &cartItem, nil
 }
 
+func (c *CartRepository) GetCartItemByCartItemIDAndCartID(cartItemID, cartID uint) (*models.CartItem, error) {
+	var cartItem models.CartItem
+	if err := c.db.Where("id = ? AND cart_id = ?", cartItemID, cartID).First(&cartItem).Error; err != nil {
+		return nil, errors.New("cart item not found")
+	}
+	return &cartItem, nil
+}
+
 func (c *CartRepository) CreateCartItem(cartItem *models.CartItem) error {
 	return c.db.Create(&cartItem).Error
 }
@@ -73,3 +117,106 @@ func (c *CartRepository) RemoveCartItemFromCart(userID, cartItemID uint) error {
 			Where("user_id = ?", userID)).
 		Delete(&models.CartItem{}).Error
 }
+
+func (c *CartRepository) RemoveCartItemFromCartByID(cartID, cartItemID uint) error {
+	return c.db.Unscoped().Where("id = ? AND cart_id = ?", cartItemID, cartID).
+		Delete(&models.CartItem{}).Error
+}
+
+// MergeGuestCart moves the items of a guest cart into the user's cart, which
+// is created if the user has none yet, and deletes the guest cart. Quantities
+// of a product in both carts are added together and capped at its stock. It
+// returns how each guest cart item was merged, and nothing when the guest
+// cart no longer exists.
+func (c *CartRepository) MergeGuestCart(guestCartID, userID uint) ([]models.CartMergeLine, error) {
+	var lines []models.CartMergeLine
+	err := c.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the guest cart so the same cart can't be merged twice at once
+		var guestCart models.Cart
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("CartItems.Product").Preload("CartItems.Product.Options", byPosition).
+			Preload("CartItems.Variant.OptionValues").
+			Where("id = ? AND user_id IS NULL", guestCartID).First(&guestCart).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		var cart models.Cart
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("CartItems").
+			Where("user_id = ?", userID).First(&cart).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			cart = models.Cart{UserID: &userID}
+			err = tx.Create(&cart).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		inCart := make(map[models.CartLine]*models.CartItem, len(cart.CartItems))
+		for i := range cart.CartItems {
+			inCart[cart.CartItems[i].Line()] = &cart.CartItems[i]
+		}
+
+		for i := range guestCart.CartItems {
+			guestItem := &guestCart.CartItems[i]
+			line := models.CartMergeLine{
+				ProductID:         guestItem.ProductID,
+				ProductName:       guestItem.Product.DisplayName(guestItem.Variant),
+				RequestedQuantity: guestItem.Quantity,
+			}
+
+			stock := guestItem.Product.Stock
+			available := guestItem.Product.ID != 0 && guestItem.Product.IsActive
+			if guestItem.VariantID != nil {
+				available = available && guestItem.Variant != nil && guestItem.Variant.IsActive
+				if guestItem.Variant != nil {
+					stock = guestItem.Variant.Stock
+				}
+			}
+			if !available {
+				line.Unavailable = true
+				lines = append(lines, line)
+				continue
+			}
+
+			item, ok := inCart[guestItem.Line()]
+			existing := 0
+			if ok {
+				existing = item.Quantity
+			}
+			line.AddedQuantity = max(min(guestItem.Quantity, stock-existing), 0)
+			lines = append(lines, line)
+			if line.AddedQuantity == 0 {
+				continue
+			}
+
+			if ok {
+				if err := tx.Model(item).Update("quantity", existing+line.AddedQuantity).Error; err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := tx.Create(&models.CartItem{
+				CartID:    cart.ID,
+				ProductID: guestItem.ProductID,
+				VariantID: guestItem.VariantID,
+				Quantity:  line.AddedQuantity,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Unscoped().Where("cart_id = ?", guestCart.ID).Delete(&models.CartItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&guestCart).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+)
+
+var _ CouponRepositoryInterface = (*CouponRepository)(nil)
+
+type CouponRepository struct {
+	db *gorm.DB
+}
+
+func NewCouponRepository(db *gorm.DB) *CouponRepository {
+	return &CouponRepository{db: db}
+}
+
+// Create implements CouponRepositoryInterface.
+// Only the links to the coupon's Products and Categories are written; the
+// products and categories themselves must already exist.
+func (r *CouponRepository) Create(coupon *models.Coupon) error {
+	return r.db.Omit("Products.*", "Categories.*").Create(coupon).Error
+}
+
+// GetByCode implements CouponRepositoryInterface.
+func (r *CouponRepository) GetByCode(code string) (*models.Coupon, error) {
+	var coupon models.Coupon
+	if err := r.db.Preload("Products").Preload("Categories").
+		Where("code = ?", code).
+		First(&coupon).Error; err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+// GetCoupons implements CouponRepositoryInterface.
+func (r *CouponRepository) GetCoupons(offset, limit int) ([]models.Coupon, error) {
+	var coupons []models.Coupon
+	if err := r.db.Preload("Products").Preload("Categories").
+		Order("created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&coupons).Error; err != nil {
+		return nil, err
+	}
+	return coupons, nil
+}
+
+// GetCouponsCount implements CouponRepositoryInterface.
+func (r *CouponRepository) GetCouponsCount() (int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Coupon{}).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// CountRedemptionsByUser implements CouponRepositoryInterface.
+func (r *CouponRepository) CountRedemptionsByUser(couponID, userID uint) (int64, error) {
+	return countCouponRedemptions(r.db, couponID, userID)
+}
+
+func countCouponRedemptions(db *gorm.DB, couponID, userID uint) (int64, error) {
+	var total int64
+	if err := db.Model(&models.CouponRedemption{}).
+		Where("coupon_id = ? AND user_id = ?", couponID, userID).
+		Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ IdempotencyRepositoryInterface = (*IdempotencyRepository)(nil)
+
+type IdempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// CreateIfAbsent implements IdempotencyRepositoryInterface.
+// It reports false without an error when the owner already has a record for the key.
+func (r *IdempotencyRepository) CreateIfAbsent(record *models.IdempotencyKey) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// GetByOwnerAndKey implements IdempotencyRepositoryInterface.
+func (r *IdempotencyRepository) GetByOwnerAndKey(owner models.IdempotencyOwner, key string) (*models.IdempotencyKey, error) {
+	query := r.db.Where("key = ?", key)
+	if owner.UserID != nil {
+		query = query.Where("user_id = ?", *owner.UserID)
+	} else {
+		query = query.Where("cart_id = ?", owner.CartID)
+	}
+
+	var record models.IdempotencyKey
+	if err := query.First(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Update implements IdempotencyRepositoryInterface.
+func (r *IdempotencyRepository) Update(record *models.IdempotencyKey) error {
+	return r.db.Save(record).Error
+}
+
+// Delete implements IdempotencyRepositoryInterface.
+func (r *IdempotencyRepository) Delete(id uint) error {
+	return r.db.Delete(&models.IdempotencyKey{}, id).Error
+}
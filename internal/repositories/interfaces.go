@@ -1,6 +1,12 @@
 package repositories
 
-import "github.com/vijayaragavanmg/learning-go-shop/internal/models"
+import (
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/tax"
+)
 
 type UserRepositoryInterface interface {
 	GetByEmail(email string) (*models.User, error)
@@ -18,38 +24,233 @@ type UserRepositoryInterface interface {
 
 type CartRepositoryInterface interface {
 	GetByUserID(userID uint) (*models.Cart, error)
+	GetGuestCart(cartID uint) (*models.Cart, error)
 	Create(cart *models.Cart) error
 	Update(cart *models.Cart) error
+	SetCoupon(cartID uint, couponID *uint) error
 	Delete(id uint) error
 
-	GetCartItemByCartIDAndProductID(cartID, productID uint) (*models.CartItem, error)
+	GetCartItemByCartIDAndProductID(cartID, productID uint, variantID *uint) (*models.CartItem, error)
 	GetCartItemByCartItemIDAndUserID(cartItemID, userID uint) (*models.CartItem, error)
+	GetCartItemByCartItemIDAndCartID(cartItemID, cartID uint) (*models.CartItem, error)
 	CreateCartItem(cartItem *models.CartItem) error
 	UpdateCartItem(cartItem *models.CartItem) error
 	RemoveCartItemFromCart(userID, cartItemID uint) error
+	RemoveCartItemFromCartByID(cartID, cartItemID uint) error
+	MergeGuestCart(guestCartID, userID uint) ([]models.CartMergeLine, error)
+}
+
+// ProductFilter narrows a product listing to a category, and the
+// categories under it when IncludeSubcategories is set. Zero values don't
+// filter. Sort is one of the keys of productSortOrders; listings are newest
+// first and searches best match first by default.
+type ProductFilter struct {
+	CategoryID           *uint
+	IncludeSubcategories bool
+	Sort                 string
+}
+
+// ProductSearch is a full-text search of the active products, narrowed down
+// by the filters the shopper chose. Zero values don't filter. Words of the
+// query that have search synonyms also match the products of any of them.
+type ProductSearch struct {
+	Query string
+	ProductFilter
+	MinPrice   *money.Money
+	MaxPrice   *money.Money
+	InStock    *bool
+	Attributes []AttributeFilter
+}
+
+// AttributeFilter matches products with an active variant that has one of
+// Values for the option named Option, ignoring case.
+type AttributeFilter struct {
+	Option string
+	Values []string
 }
 
 type ProductRepositoryInterface interface {
-	CreateCategory(name, description string) (*models.Category, error)
+	CreateCategory(parentID *uint, name, slug, description string) (*models.Category, error)
 	GetCategoriesByID(id uint) (*models.Category, error)
+	GetCategoryBySlug(slug string) (*models.Category, error)
 	GetCategoriesByStatus(is_active bool) ([]models.Category, error)
-	UpdateCategory(category *models.Category) error
+	// GetChildCategoriesByStatus returns the categories directly under a category.
+	GetChildCategoriesByStatus(parentID uint, isActive bool) ([]models.Category, error)
+	GetChildCategoryCount(parentID uint) (int64, error)
+	// GetCategoryPath returns a category and its ancestors, top-level
+	// category first.
+	GetCategoryPath(id uint) ([]models.Category, error)
+	// UpdateCategory saves a category. When it has a parent, checkParent is
+	// given the parent's path, nil if there is no such category, while other
+	// category writes are held off, and an error from it stops the update.
+	UpdateCategory(category *models.Category, checkParent func(path []models.Category) error) error
 	DeleteCategory(id uint) error
 
-	CreateProduct(categoryID uint, name string, description string, price float64, stock int, sku string) (*models.Product, error)
+	CreateProduct(categoryID uint, name string, description string, price money.Money, stock int, sku string, taxClass string, weightGrams int) (*models.Product, error)
 	GetProductByID(id uint) (*models.Product, error)
-	GetProductsByStatus(is_active bool, offset, limit int) ([]models.Product, error)
-	GetProductsCountByStatus(is_active bool) (int64, error)
+	GetProductsByStatus(is_active bool, filter ProductFilter, offset, limit int) ([]models.Product, error)
+	GetProductsCountByStatus(is_active bool, filter ProductFilter) (int64, error)
 	UpdateProduct(product *models.Product) error
 	DeleteProduct(id uint) error
-	AddProductImages(productID uint, url string, altText string, isPrimary bool) error
+	AddProductImages(productID uint, variantID *uint, url string, altText string, isPrimary bool) error
 	GetProductImageCount(productID uint) (int64, error)
-	SearchProducts(queryString string, categoryID *uint, minPrice *float64, maxPrice *float64, offset int, limit int) ([]models.ProductsWithRank, *int64, error)
+	// CreateProductOption creates an option with its values, after the
+	// product's existing options.
+	CreateProductOption(option *models.ProductOption) error
+	GetProductVariant(productID, variantID uint) (*models.ProductVariant, error)
+	CreateProductVariant(variant *models.ProductVariant) error
+	UpdateProductVariant(variant *models.ProductVariant) error
+	// DeleteProductVariant deletes a variant and takes it out of carts.
+	DeleteProductVariant(productID, variantID uint) error
+	// SearchProducts returns a page of the products matching the search, best
+	// match first, and the facets of all of them.
+	SearchProducts(search ProductSearch, offset int, limit int) ([]models.ProductsWithRank, *models.ProductFacets, error)
+	// SuggestSearch returns up to limit products and up to limit categories
+	// whose names complete query, followed by those with names resembling it.
+	SuggestSearch(query string, limit int) ([]models.SearchSuggestion, error)
+
+	GetSearchSynonyms() ([]models.SearchSynonym, error)
+	CreateSearchSynonym(synonym *models.SearchSynonym) error
+	DeleteSearchSynonym(id uint) error
+}
+
+type AddressRepositoryInterface interface {
+	GetByUserID(userID uint) ([]models.Address, error)
+	GetByUserIDAndID(userID, addressID uint) (*models.Address, error)
+	GetDefaultShipping(userID uint) (*models.Address, error)
+	GetDefaultBilling(userID uint) (*models.Address, error)
+	Create(address *models.Address) error
+	Update(address *models.Address) error
+	Delete(userID, addressID uint) error
+}
+
+// CreateOrderParams holds the checkout details that are stored on a new order.
+// TaxCalculator must hold the rates for the shipping address, and
+// ShippingMethod must be one that ships to it.
+type CreateOrderParams struct {
+	ShippingAddress models.OrderAddress
+	BillingAddress  models.OrderAddress
+	ShippingMethod  *models.ShippingMethod
+	TaxCalculator   *tax.Calculator
+
+	// GuestEmail and GuestAccessTokenHash are stored on guest orders only.
+	GuestEmail           string
+	GuestAccessTokenHash string
+}
+
+// OrderFilter narrows the order book across all users. Zero values don't
+// filter. SortBy is "created_at" or "total_amount", newest or largest first
+// unless Ascending is set.
+type OrderFilter struct {
+	Status      models.OrderStatus
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UserEmail   string
+	MinTotal    *money.Money
+	SortBy      string
+	Ascending   bool
 }
 
 type OrderRepositoryInterface interface {
-	CreateOrder(userID uint) (*models.Order, error)
+	CreateOrder(userID uint, params CreateOrderParams) (*models.Order, error)
+	CreateGuestOrder(cartID uint, params CreateOrderParams) (*models.Order, error)
 	GetOrderByUserIDAndOrderID(userID, orderID uint) (*models.Order, error)
+	GetGuestOrder(email, accessTokenHash string) (*models.Order, error)
+	// ClaimGuestOrder moves the guest order placed with email and the access
+	// token to the user. Orders that already belong to a user aren't claimed.
+	ClaimGuestOrder(userID uint, email, accessTokenHash string) (*models.Order, error)
 	GetOrders(userID uint, offset, limit int) ([]models.Order, error)
 	GetOrdersCount(userID uint) (int64, error)
+	GetOrderByID(orderID uint) (*models.Order, error)
+	GetAllOrders(filter OrderFilter, offset, limit int) ([]models.Order, error)
+	GetAllOrdersCount(filter OrderFilter) (int64, error)
+	UpdateOrderStatus(orderID uint, status models.OrderStatus, reason string) (*models.Order, models.OrderStatus, error)
+	CancelOrder(userID, orderID uint, reason string) (*models.Order, models.OrderStatus, error)
+	// CancelUnpaidOrder cancels a pending order whose payment didn't go
+	// through and puts its items and coupon back in the cart it came from:
+	// the user's cart, or guestCartID for guest orders when it is known.
+	CancelUnpaidOrder(orderID uint, guestCartID *uint, reason string) (*models.Order, models.OrderStatus, error)
+	CreateShipment(orderID uint, shipment *models.Shipment) (*models.Order, models.OrderStatus, error)
+	MarkShipmentDelivered(shipmentID uint) (*models.Order, models.OrderStatus, error)
+}
+
+type CouponRepositoryInterface interface {
+	Create(coupon *models.Coupon) error
+	GetByCode(code string) (*models.Coupon, error)
+	GetCoupons(offset, limit int) ([]models.Coupon, error)
+	GetCouponsCount() (int64, error)
+	CountRedemptionsByUser(couponID, userID uint) (int64, error)
+}
+
+type TaxRateRepositoryInterface interface {
+	GetTaxRates() ([]models.TaxRate, error)
+	GetByCountry(country string) ([]models.TaxRate, error)
+	Create(rate *models.TaxRate) error
+	Delete(id uint) error
+}
+
+type PaymentRepositoryInterface interface {
+	Create(payment *models.Payment) error
+	GetByTransactionID(provider, transactionID string) (*models.Payment, error)
+	GetLatestByOrderID(orderID uint) (*models.Payment, error)
+	UpdateStatus(payment *models.Payment, status models.PaymentStatus, failureReason string) (bool, error)
+}
+
+type IdempotencyRepositoryInterface interface {
+	CreateIfAbsent(record *models.IdempotencyKey) (bool, error)
+	GetByOwnerAndKey(owner models.IdempotencyOwner, key string) (*models.IdempotencyKey, error)
+	Update(record *models.IdempotencyKey) error
+	Delete(id uint) error
+}
+
+type ShippingRepositoryInterface interface {
+	GetZones() ([]models.ShippingZone, error)
+	GetZoneByID(id uint) (*models.ShippingZone, error)
+	CreateZone(zone *models.ShippingZone) error
+	CreateMethod(method *models.ShippingMethod) error
+}
+
+type StockReservationRepositoryInterface interface {
+	// ReserveCart holds the stock for everything in the cart until expiresAt,
+	// replacing any earlier reservation of the cart. It fails when a product
+	// doesn't have enough stock left that isn't reserved for other carts.
+	ReserveCart(cartID uint, expiresAt time.Time) ([]models.StockReservation, error)
+	// DeleteExpired deletes the reservations that expired by now.
+	DeleteExpired(now time.Time) (int64, error)
+	// GetCartReservedQuantity returns how much of line the cart's active
+	// reservation holds.
+	GetCartReservedQuantity(cartID uint, line models.CartLine) (int, error)
+}
+
+type WishlistRepositoryInterface interface {
+	GetByUserID(userID uint) ([]models.Wishlist, error)
+	GetByUserIDAndID(userID, wishlistID uint) (*models.Wishlist, error)
+	GetByShareToken(token string) (*models.Wishlist, error)
+	Create(wishlist *models.Wishlist) error
+	Update(wishlist *models.Wishlist) error
+	Delete(userID, wishlistID uint) error
+	// AddItem adds a product to a wishlist. Adding a product that is already
+	// on the list does nothing.
+	AddItem(item *models.WishlistItem) error
+	GetItem(wishlistID, itemID uint) (*models.WishlistItem, error)
+	RemoveItem(wishlistID, itemID uint) error
+}
+
+type InvoiceRepositoryInterface interface {
+	UpdateFilePath(invoice *models.Invoice, path string) error
+}
+
+type ReturnRepositoryInterface interface {
+	Create(userID, orderID uint, reason string, items []models.ReturnItem) (*models.ReturnRequest, error)
+	GetByID(returnID uint) (*models.ReturnRequest, error)
+	GetByUserIDAndID(userID, returnID uint) (*models.ReturnRequest, error)
+	GetByUserID(userID uint, offset, limit int) ([]models.ReturnRequest, error)
+	GetCountByUserID(userID uint) (int64, error)
+	GetReturns(status models.ReturnStatus, offset, limit int) ([]models.ReturnRequest, error)
+	GetReturnsCount(status models.ReturnStatus) (int64, error)
+	UpdateStatus(returnID uint, status models.ReturnStatus, rejectionReason string) (*models.ReturnRequest, models.ReturnStatus, error)
+	// Refund moves a received return to refunded. refund is called with the
+	// return and its order locked, and returns the amount it paid out; when
+	// it fails the return is left unchanged.
+	Refund(returnID uint, refund func(returnRequest *models.ReturnRequest) (money.Money, error)) (*models.ReturnRequest, models.ReturnStatus, error)
 }
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+)
+
+var _ InvoiceRepositoryInterface = (*InvoiceRepository)(nil)
+
+type InvoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) *InvoiceRepository {
+	return &InvoiceRepository{db: db}
+}
+
+// UpdateFilePath implements InvoiceRepositoryInterface.
+func (r *InvoiceRepository) UpdateFilePath(invoice *models.Invoice, path string) error {
+	if err := r.db.Model(invoice).Update("file_path", path).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// issueInvoice allocates the next invoice number to an order. The counter row
+// stays locked until tx ends and a rollback undoes the increment, so numbers
+// are handed out without gaps.
+func issueInvoice(tx *gorm.DB, orderID uint, issuedAt time.Time) error {
+	var sequenceNumber int64
+	if err := tx.Raw("UPDATE invoice_counters SET last_number = last_number + 1 WHERE id = 1 RETURNING last_number").
+		Scan(&sequenceNumber).Error; err != nil {
+		return err
+	}
+	if sequenceNumber == 0 {
+		return errors.New("invoice counter is missing")
+	}
+
+	invoice := models.Invoice{
+		OrderID:        orderID,
+		SequenceNumber: sequenceNumber,
+		Number:         models.InvoiceNumber(sequenceNumber),
+		IssuedAt:       issuedAt,
+	}
+	return tx.Create(&invoice).Error
+}
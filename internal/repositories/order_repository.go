@@ -3,9 +3,13 @@ package repositories
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/tax"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ OrderRepositoryInterface = (*OrderRepository)(nil)
@@ -19,12 +23,35 @@ func NewOrderRepository(db *gorm.DB) *OrderRepository {
 }
 
 // CreateOrder implements OrderRepositoryInterface.
-func (o *OrderRepository) CreateOrder(userID uint) (*models.Order, error) {
+func (o *OrderRepository) CreateOrder(userID uint, params CreateOrderParams) (*models.Order, error) {
+	return o.createOrder(&userID, params, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("user_id = ?", userID)
+	})
+}
+
+// CreateGuestOrder implements OrderRepositoryInterface.
+func (o *OrderRepository) CreateGuestOrder(cartID uint, params CreateOrderParams) (*models.Order, error) {
+	return o.createOrder(nil, params, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id = ? AND user_id IS NULL", cartID)
+	})
+}
+
+// createOrder checks out the cart selected by scope. userID is nil for guest
+// orders, which are stored with the guest details from params instead.
+func (o *OrderRepository) createOrder(userID *uint, params CreateOrderParams, scope func(tx *gorm.DB) *gorm.DB) (*models.Order, error) {
 	var orderResponse *models.Order
 	err := o.db.Transaction(func(tx *gorm.DB) error {
 
+		// Lock the cart so a retried checkout for the same user waits for this one
 		var cart models.Cart
-		if err := tx.Preload("CartItems.Product").Where("user_id = ?", userID).First(&cart).Error; err != nil {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("CartItems", func(db *gorm.DB) *gorm.DB {
+				// decrement stock in a stable order so concurrent checkouts can't deadlock
+				return db.Order("product_id, variant_id")
+			}).
+			Preload("CartItems.Product.Images").Preload("CartItems.Product.Options", byPosition).
+			Preload("CartItems.Variant.OptionValues").
+			Scopes(scope).First(&cart).Error; err != nil {
 			return errors.New("cart not found")
 		}
 
@@ -32,50 +59,141 @@ func (o *OrderRepository) CreateOrder(userID uint) (*models.Order, error) {
 			return errors.New("cart is empty")
 		}
 
-		// Calculate total and validate stock
-		var totalAmount float64
-		var orderItems []models.OrderItem
+		// Validate stock and snapshot the items
+		orderItems := make([]models.OrderItem, len(cart.CartItems))
+		taxLines := make([]tax.Line, len(cart.CartItems))
 
 		for i := range cart.CartItems {
 			cartItem := &cart.CartItems[i]
 
-			if cartItem.Product.Stock < cartItem.Quantity {
-				return fmt.Errorf("insufficient stock for product: %s", cartItem.Product.Name)
+			if err := decrementStock(tx, cart.ID, cartItem); err != nil {
+				return err
 			}
 
-			itemTotal := float64(cartItem.Quantity) * cartItem.Product.Price
-			totalAmount += itemTotal
+			itemTotal := cartItem.UnitPrice().Mul(cartItem.Quantity)
 
-			orderItems = append(orderItems, models.OrderItem{
-				ProductID: cartItem.ProductID,
-				Quantity:  cartItem.Quantity,
-				Price:     itemTotal,
-			})
+			orderItems[i] = models.OrderItem{
+				ProductID:   cartItem.ProductID,
+				Quantity:    cartItem.Quantity,
+				Price:       itemTotal,
+				ProductName: cartItem.Product.Name,
+				ProductSKU:  cartItem.Product.SKU,
+				UnitPrice:   cartItem.UnitPrice(),
+				LineTotal:   itemTotal,
+				ImageURL:    cartItem.Product.PrimaryImageURL(),
+			}
+			if cartItem.Variant != nil {
+				orderItems[i].VariantID = &cartItem.Variant.ID
+				orderItems[i].VariantName = cartItem.Product.VariantName(cartItem.Variant)
+				orderItems[i].ProductSKU = cartItem.Variant.SKU
+				orderItems[i].ImageURL = cartItem.Product.VariantImageURL(cartItem.Variant.ID)
+			}
+			taxLines[i] = tax.Line{
+				Amount:   itemTotal,
+				Discount: money.New(0),
+				TaxClass: cartItem.Product.TaxClass,
+			}
+		}
 
-			// Update product stock
-			cartItem.Product.Stock -= cartItem.Quantity
-			if err := tx.Save(&cartItem.Product).Error; err != nil {
+		// The cart's reservations are now taken off the stock
+		if err := tx.Where("cart_id = ?", cart.ID).Delete(&models.StockReservation{}).Error; err != nil {
+			return err
+		}
+
+		// Redeem the cart's coupon in the same transaction so usage limits hold under concurrency.
+		// Guest carts can't hold a coupon, as usage limits are counted per user
+		var coupon *models.Coupon
+		if cart.CouponID != nil && userID != nil {
+			var (
+				lineDiscounts []money.Money
+				err           error
+			)
+			coupon, lineDiscounts, err = redeemCoupon(tx, *userID, &cart)
+			if err != nil {
 				return err
 			}
+			for i := range taxLines {
+				taxLines[i].Discount = lineDiscounts[i]
+			}
 		}
+
+		// Tax is worked out per line on the discounted amount, for the shipping address
+		taxAddress := tax.Address{
+			Country: params.ShippingAddress.Country,
+			Region:  params.ShippingAddress.Region,
+		}
+		taxResult := params.TaxCalculator.Calculate(taxAddress, taxLines)
+		for i := range orderItems {
+			orderItems[i].DiscountAmount = taxLines[i].Discount
+			orderItems[i].TaxAmount = taxResult.Lines[i].Tax
+			orderItems[i].TaxRateBasisPoints = taxResult.Lines[i].BasisPoints
+		}
+
+		// Shipping is quoted on the discounted goods and taxed as its own line
+		shippingAmount := params.ShippingMethod.Quote(taxResult.Subtotal.Sub(taxResult.Discount), models.ShippingWeight(cart.CartItems))
+		shippingDiscount := money.New(0)
+		if coupon != nil && coupon.Type == models.CouponTypeFreeShipping {
+			shippingDiscount, shippingAmount = shippingAmount, money.New(0)
+		}
+		shippingTax := params.TaxCalculator.Calculate(taxAddress, []tax.Line{{
+			Amount:   shippingAmount,
+			Discount: money.New(0),
+			TaxClass: tax.ShippingClass,
+		}})
+
 		// Create order
 		order := models.Order{
-			UserID:      userID,
-			Status:      models.OrderStatusPending,
-			TotalAmount: totalAmount,
-			OrderItems:  orderItems,
+			UserID:             userID,
+			Status:             models.OrderStatusPending,
+			SubtotalAmount:     taxResult.Subtotal,
+			DiscountAmount:     taxResult.Discount,
+			ShippingMethodID:   &params.ShippingMethod.ID,
+			ShippingMethodName: params.ShippingMethod.Name,
+			ShippingAmount:     shippingAmount,
+			TaxAmount:          taxResult.Tax.Add(shippingTax.Tax),
+			TotalAmount:        taxResult.Total.Add(shippingTax.Total),
+			PricesIncludeTax:   params.TaxCalculator.PricesIncludeTax(),
+			ShippingAddress:    params.ShippingAddress,
+			BillingAddress:     params.BillingAddress,
+			OrderItems:         orderItems,
+		}
+
+		if coupon != nil {
+			order.CouponCode = coupon.Code
+		}
+
+		if userID == nil {
+			order.GuestEmail = &params.GuestEmail
+			order.GuestAccessTokenHash = &params.GuestAccessTokenHash
 		}
 
 		if err := tx.Create(&order).Error; err != nil {
 			return err
 		}
 
-		// Clear cart
-		if err := tx.Where("cart_id = ?", cart.ID).Delete(&models.CartItem{}).Error; err != nil {
+		if coupon != nil {
+			redemption := models.CouponRedemption{
+				CouponID: coupon.ID,
+				UserID:   *userID,
+				OrderID:  order.ID,
+				Amount:   order.DiscountAmount.Add(shippingDiscount),
+			}
+			if err := tx.Create(&redemption).Error; err != nil {
+				return err
+			}
+		}
+
+		// Clear cart. The rows are removed outright, as RemoveCartItemFromCart
+		// does, so the same products can be added to the cart again later
+		if err := tx.Unscoped().Where("cart_id = ?", cart.ID).Delete(&models.CartItem{}).Error; err != nil {
 			return err
 		}
 
-		if err := tx.Preload("OrderItems.Product.Category").First(&order, order.ID).Error; err != nil {
+		if err := tx.Model(&cart).Update("coupon_id", nil).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").First(&order, order.ID).Error; err != nil {
 			return err
 		}
 		orderResponse = &order
@@ -87,10 +205,104 @@ func (o *OrderRepository) CreateOrder(userID uint) (*models.Order, error) {
 	return orderResponse, nil
 }
 
+// decrementStock takes the cart item's quantity off the stock of its product,
+// or of its variant, in a single conditional UPDATE. The stock check and the
+// write happen atomically in the database, so concurrent checkouts can never
+// take the stock below zero. Stock reserved for other carts can't be taken,
+// while the cart's own reservation is used up.
+func decrementStock(tx *gorm.DB, cartID uint, cartItem *models.CartItem) error {
+	product := &cartItem.Product
+	if err := lockProduct(tx, product.ID); err != nil {
+		return err
+	}
+
+	var result *gorm.DB
+	if cartItem.VariantID != nil {
+		if cartItem.Variant == nil || !cartItem.Variant.IsActive {
+			return fmt.Errorf("product is no longer available: %s", product.Name)
+		}
+
+		result = tx.Model(&models.ProductVariant{}).
+			Where("id = ? AND stock - COALESCE((SELECT SUM(quantity) FROM stock_reservations "+
+				"WHERE variant_id = ? AND cart_id <> ? AND expires_at > NOW()), 0) >= ?",
+				cartItem.Variant.ID, cartItem.Variant.ID, cartID, cartItem.Quantity).
+			Update("stock", gorm.Expr("stock - ?", cartItem.Quantity))
+	} else {
+		result = tx.Model(&models.Product{}).
+			Where("id = ? AND stock - COALESCE((SELECT SUM(quantity) FROM stock_reservations "+
+				"WHERE product_id = ? AND variant_id IS NULL AND cart_id <> ? AND expires_at > NOW()), 0) >= ?",
+				product.ID, product.ID, cartID, cartItem.Quantity).
+			Update("stock", gorm.Expr("stock - ?", cartItem.Quantity))
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("insufficient stock for product: %s", product.DisplayName(cartItem.Variant))
+	}
+	return nil
+}
+
+// redeemCoupon locks the cart's coupon, checks that it can still be used by the
+// user and counts the use. It returns the coupon and its discount on each cart item.
+func redeemCoupon(tx *gorm.DB, userID uint, cart *models.Cart) (*models.Coupon, []money.Money, error) {
+	var coupon models.Coupon
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Products").Preload("Categories").
+		First(&coupon, *cart.CouponID).Error; err != nil {
+		return nil, nil, errors.New("coupon not found")
+	}
+
+	if err := coupon.CheckAvailable(time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	if coupon.UsageLimitPerUser > 0 {
+		used, err := countCouponRedemptions(tx, coupon.ID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if used >= int64(coupon.UsageLimitPerUser) {
+			return nil, nil, errors.New("coupon usage limit reached")
+		}
+	}
+
+	lineDiscounts, err := coupon.LineDiscounts(cart.CartItems)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Model(&coupon).Update("times_used", gorm.Expr("times_used + 1")).Error; err != nil {
+		return nil, nil, err
+	}
+	return &coupon, lineDiscounts, nil
+}
+
+// releaseCouponRedemption gives the coupon use of a cancelled order back so it
+// no longer counts against the usage limits.
+func releaseCouponRedemption(tx *gorm.DB, orderID uint) error {
+	var redemptions []models.CouponRedemption
+	if err := tx.Clauses(clause.Returning{}).
+		Where("order_id = ?", orderID).
+		Delete(&redemptions).Error; err != nil {
+		return err
+	}
+
+	for i := range redemptions {
+		if err := tx.Model(&models.Coupon{}).
+			Where("id = ? AND times_used > 0", redemptions[i].CouponID).
+			Update("times_used", gorm.Expr("times_used - 1")).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetOrderByUserIDAndOrderID implements OrderRepositoryInterface.
 func (o *OrderRepository) GetOrderByUserIDAndOrderID(userID uint, orderID uint) (*models.Order, error) {
 	var order models.Order
-	if err := o.db.Preload("OrderItems.Product.Category").
+	if err := o.db.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").
 		Where("id = ? AND user_id = ?", orderID, userID).
 		First(&order).Error; err != nil {
 		return nil, err
@@ -99,10 +311,36 @@ func (o *OrderRepository) GetOrderByUserIDAndOrderID(userID uint, orderID uint)
 
 }
 
+// GetGuestOrder implements OrderRepositoryInterface.
+func (o *OrderRepository) GetGuestOrder(email, accessTokenHash string) (*models.Order, error) {
+	var order models.Order
+	if err := o.db.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").
+		Where("guest_access_token_hash = ? AND LOWER(guest_email) = LOWER(?)", accessTokenHash, email).
+		First(&order).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// ClaimGuestOrder implements OrderRepositoryInterface.
+func (o *OrderRepository) ClaimGuestOrder(userID uint, email, accessTokenHash string) (*models.Order, error) {
+	result := o.db.Model(&models.Order{}).
+		Where("user_id IS NULL AND guest_access_token_hash = ? AND LOWER(guest_email) = LOWER(?)", accessTokenHash, email).
+		Update("user_id", userID)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return o.GetGuestOrder(email, accessTokenHash)
+}
+
 // GetOrders implements OrderRepositoryInterface.
 func (o *OrderRepository) GetOrders(userID uint, offset int, limit int) ([]models.Order, error) {
 	var orders []models.Order
-	if err := o.db.Preload("OrderItems.Product.Category").
+	if err := o.db.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").
 		Where("user_id = ?", userID).
 		Order("created_at DESC").
 		Offset(offset).Limit(limit).
@@ -122,3 +360,436 @@ func (o *OrderRepository) GetOrdersCount(userID uint) (int64, error) {
 	return total, nil
 
 }
+
+// GetOrderByID implements OrderRepositoryInterface.
+func (o *OrderRepository) GetOrderByID(orderID uint) (*models.Order, error) {
+	var order models.Order
+	if err := o.db.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").Preload("User").
+		First(&order, orderID).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// orderSortColumns maps the sort keys of OrderFilter to order columns.
+var orderSortColumns = map[string]string{
+	"created_at":   "orders.created_at",
+	"total_amount": "orders.total_amount",
+}
+
+// GetAllOrders implements OrderRepositoryInterface.
+func (o *OrderRepository) GetAllOrders(filter OrderFilter, offset, limit int) ([]models.Order, error) {
+	column, ok := orderSortColumns[filter.SortBy]
+	if !ok {
+		column = orderSortColumns["created_at"]
+	}
+
+	var orders []models.Order
+	if err := filterOrders(o.db, filter).
+		Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").Preload("User").
+		Order(clause.OrderByColumn{Column: clause.Column{Name: column, Raw: true}, Desc: !filter.Ascending}).
+		Order("orders.id DESC").
+		Offset(offset).Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetAllOrdersCount implements OrderRepositoryInterface.
+func (o *OrderRepository) GetAllOrdersCount(filter OrderFilter) (int64, error) {
+	var total int64
+	if err := filterOrders(o.db.Model(&models.Order{}), filter).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// filterOrders applies the conditions of filter to an orders query.
+func filterOrders(db *gorm.DB, filter OrderFilter) *gorm.DB {
+	if filter.Status != "" {
+		db = db.Where("orders.status = ?", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		db = db.Where("orders.created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		db = db.Where("orders.created_at < ?", *filter.CreatedTo)
+	}
+	if filter.MinTotal != nil {
+		db = db.Where("orders.total_amount >= ?", *filter.MinTotal)
+	}
+	if filter.UserEmail != "" {
+		db = db.Joins("LEFT JOIN users ON users.id = orders.user_id").
+			Where("(LOWER(users.email) = LOWER(?) OR LOWER(orders.guest_email) = LOWER(?))", filter.UserEmail, filter.UserEmail)
+	}
+	return db
+}
+
+// UpdateOrderStatus implements OrderRepositoryInterface.
+func (o *OrderRepository) UpdateOrderStatus(orderID uint, status models.OrderStatus, reason string) (*models.Order, models.OrderStatus, error) {
+	return o.transitionOrder(status, reason, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id = ?", orderID)
+	}, nil)
+}
+
+// CancelOrder implements OrderRepositoryInterface.
+func (o *OrderRepository) CancelOrder(userID, orderID uint, reason string) (*models.Order, models.OrderStatus, error) {
+	return o.transitionOrder(models.OrderStatusCancelled, reason, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id = ? AND user_id = ?", orderID, userID)
+	}, nil)
+}
+
+// CancelUnpaidOrder implements OrderRepositoryInterface.
+func (o *OrderRepository) CancelUnpaidOrder(orderID uint, guestCartID *uint, reason string) (*models.Order, models.OrderStatus, error) {
+	return o.transitionOrder(models.OrderStatusCancelled, reason, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id = ? AND status = ?", orderID, models.OrderStatusPending)
+	}, func(tx *gorm.DB, order *models.Order) error {
+		cartScope := func(tx *gorm.DB) *gorm.DB {
+			return tx.Where("user_id = ?", *order.UserID)
+		}
+		if order.UserID == nil {
+			if guestCartID == nil {
+				return nil
+			}
+			cartScope = func(tx *gorm.DB) *gorm.DB {
+				return tx.Where("id = ? AND user_id IS NULL", *guestCartID)
+			}
+		}
+		return restoreCart(tx, order.ID, cartScope)
+	})
+}
+
+// restoreCart puts the items of an order back in the cart selected by scope,
+// adding to the quantities already in it, along with the coupon the order
+// redeemed. It must run before the coupon redemption is released. A cart
+// that no longer exists is left alone.
+func restoreCart(tx *gorm.DB, orderID uint, scope func(tx *gorm.DB) *gorm.DB) error {
+	var cart models.Cart
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("CartItems").
+		Scopes(scope).First(&cart).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var orderItems []models.OrderItem
+	if err := tx.Where("order_id = ?", orderID).Find(&orderItems).Error; err != nil {
+		return err
+	}
+
+	inCart := make(map[models.CartLine]*models.CartItem, len(cart.CartItems))
+	for i := range cart.CartItems {
+		inCart[cart.CartItems[i].Line()] = &cart.CartItems[i]
+	}
+
+	for i := range orderItems {
+		orderItem := &orderItems[i]
+		restored := models.CartItem{
+			CartID:    cart.ID,
+			ProductID: orderItem.ProductID,
+			VariantID: orderItem.VariantID,
+			Quantity:  orderItem.Quantity,
+		}
+
+		if item, ok := inCart[restored.Line()]; ok {
+			if err := tx.Model(item).Update("quantity", item.Quantity+orderItem.Quantity).Error; err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := tx.Create(&restored).Error; err != nil {
+			return err
+		}
+	}
+
+	var redemption models.CouponRedemption
+	err = tx.Where("order_id = ?", orderID).First(&redemption).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Model(&cart).Update("coupon_id", redemption.CouponID).Error
+}
+
+// transitionOrder moves the order selected by scope to status and returns it
+// together with the status it had before. The order row is locked for the
+// duration of the transaction so concurrent transitions are applied one after
+// another against the current status. Cancelling an order puts the stock of
+// every order item back in the same transaction. prepare, when set, runs in
+// the transaction once the transition is allowed, before it is applied.
+func (o *OrderRepository) transitionOrder(status models.OrderStatus, reason string, scope func(tx *gorm.DB) *gorm.DB, prepare func(tx *gorm.DB, order *models.Order) error) (*models.Order, models.OrderStatus, error) {
+	var (
+		orderResponse  *models.Order
+		previousStatus models.OrderStatus
+	)
+	err := o.db.Transaction(func(tx *gorm.DB) error {
+		var order models.Order
+		if err := scope(tx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&order).Error; err != nil {
+			return errors.New("order not found")
+		}
+
+		if !order.Status.CanTransitionTo(status) {
+			return fmt.Errorf("cannot change order status from %s to %s", order.Status, status)
+		}
+
+		if prepare != nil {
+			if err := prepare(tx, &order); err != nil {
+				return err
+			}
+		}
+
+		if status == models.OrderStatusCancelled {
+			var shipments int64
+			if err := tx.Model(&models.Shipment{}).Where("order_id = ?", order.ID).Count(&shipments).Error; err != nil {
+				return err
+			}
+			if shipments > 0 {
+				return errors.New("order has shipments and can no longer be cancelled")
+			}
+
+			if err := restockOrderItems(tx, order.ID); err != nil {
+				return err
+			}
+			if err := releaseCouponRedemption(tx, order.ID); err != nil {
+				return err
+			}
+			order.CancellationReason = reason
+		}
+
+		previousStatus = order.Status
+		order.MarkStatus(status, time.Now())
+		if err := tx.Save(&order).Error; err != nil {
+			return err
+		}
+
+		if status == models.OrderStatusConfirmed {
+			if err := issueInvoice(tx, order.ID, *order.ConfirmedAt); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").First(&order, order.ID).Error; err != nil {
+			return err
+		}
+		orderResponse = &order
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return orderResponse, previousStatus, nil
+}
+
+// CreateShipment implements OrderRepositoryInterface.
+// The order is locked while the shipment is checked and written, so
+// concurrent shipments can't send more of an item than was ordered. Once
+// every item has shipped the order moves to shipped. It returns the order
+// and, when its status changed, the status it had before.
+func (o *OrderRepository) CreateShipment(orderID uint, shipment *models.Shipment) (*models.Order, models.OrderStatus, error) {
+	var (
+		orderResponse  *models.Order
+		previousStatus models.OrderStatus
+	)
+	err := o.db.Transaction(func(tx *gorm.DB) error {
+		var order models.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("OrderItems").
+			First(&order, orderID).Error; err != nil {
+			return errors.New("order not found")
+		}
+
+		if order.Status != models.OrderStatusConfirmed {
+			return fmt.Errorf("cannot ship a %s order", order.Status)
+		}
+
+		shipped, err := shippedQuantities(tx, orderID)
+		if err != nil {
+			return err
+		}
+
+		remaining := make(map[uint]int, len(order.OrderItems))
+		for i := range order.OrderItems {
+			item := &order.OrderItems[i]
+			if left := item.Quantity - shipped[item.ID]; left > 0 {
+				remaining[item.ID] = left
+			}
+		}
+
+		// Without items the shipment holds everything not shipped yet
+		if len(shipment.Items) == 0 {
+			for i := range order.OrderItems {
+				if left := remaining[order.OrderItems[i].ID]; left > 0 {
+					shipment.Items = append(shipment.Items, models.ShipmentItem{
+						OrderItemID: order.OrderItems[i].ID,
+						Quantity:    left,
+					})
+				}
+			}
+		}
+
+		listed := make(map[uint]bool, len(shipment.Items))
+		for i := range shipment.Items {
+			orderItemID := shipment.Items[i].OrderItemID
+			if listed[orderItemID] {
+				return fmt.Errorf("order item %d is listed more than once", orderItemID)
+			}
+			listed[orderItemID] = true
+
+			if shipment.Items[i].Quantity > remaining[orderItemID] {
+				return fmt.Errorf("only %d of order item %d are left to ship", remaining[orderItemID], orderItemID)
+			}
+			remaining[orderItemID] -= shipment.Items[i].Quantity
+			if remaining[orderItemID] == 0 {
+				delete(remaining, orderItemID)
+			}
+		}
+
+		shipment.OrderID = orderID
+		shipment.Status = models.ShipmentStatusShipped
+		shipment.ShippedAt = time.Now()
+		if err := tx.Omit("Items.OrderItem").Create(shipment).Error; err != nil {
+			return err
+		}
+
+		if len(remaining) == 0 {
+			previousStatus = order.Status
+			order.MarkStatus(models.OrderStatusShipped, shipment.ShippedAt)
+			if err := tx.Omit(clause.Associations).Save(&order).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").First(&order, order.ID).Error; err != nil {
+			return err
+		}
+		orderResponse = &order
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return orderResponse, previousStatus, nil
+}
+
+// shippedQuantities sums the quantity of each order item already in a shipment.
+func shippedQuantities(tx *gorm.DB, orderID uint) (map[uint]int, error) {
+	var rows []struct {
+		OrderItemID uint
+		Quantity    int
+	}
+	if err := tx.Model(&models.ShipmentItem{}).
+		Select("shipment_items.order_item_id, SUM(shipment_items.quantity) AS quantity").
+		Joins("JOIN shipments ON shipments.id = shipment_items.shipment_id").
+		Where("shipments.order_id = ?", orderID).
+		Group("shipment_items.order_item_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	shipped := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		shipped[row.OrderItemID] = row.Quantity
+	}
+	return shipped, nil
+}
+
+// MarkShipmentDelivered implements OrderRepositoryInterface.
+// Once the order has fully shipped and all of its shipments are delivered the
+// order moves to delivered. It returns the order and, when its status
+// changed, the status it had before.
+func (o *OrderRepository) MarkShipmentDelivered(shipmentID uint) (*models.Order, models.OrderStatus, error) {
+	var (
+		orderResponse  *models.Order
+		previousStatus models.OrderStatus
+	)
+	err := o.db.Transaction(func(tx *gorm.DB) error {
+		var shipment models.Shipment
+		if err := tx.First(&shipment, shipmentID).Error; err != nil {
+			return errors.New("shipment not found")
+		}
+
+		// Lock the order first, the same order CreateShipment takes its locks in
+		var order models.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, shipment.OrderID).Error; err != nil {
+			return errors.New("order not found")
+		}
+
+		now := time.Now()
+		result := tx.Model(&models.Shipment{}).
+			Where("id = ? AND status = ?", shipment.ID, models.ShipmentStatusShipped).
+			Updates(map[string]interface{}{
+				"status":       models.ShipmentStatusDelivered,
+				"delivered_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("shipment has already been delivered")
+		}
+
+		if order.Status == models.OrderStatusShipped {
+			var undelivered int64
+			if err := tx.Model(&models.Shipment{}).
+				Where("order_id = ? AND status <> ?", order.ID, models.ShipmentStatusDelivered).
+				Count(&undelivered).Error; err != nil {
+				return err
+			}
+
+			if undelivered == 0 {
+				previousStatus = order.Status
+				order.MarkStatus(models.OrderStatusDelivered, now)
+				if err := tx.Save(&order).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := tx.Scopes(withProductDetails("OrderItems.Product")).Preload("Payments").Preload("Shipments.Items").Preload("Invoice").First(&order, order.ID).Error; err != nil {
+			return err
+		}
+		orderResponse = &order
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return orderResponse, previousStatus, nil
+}
+
+// restockOrderItems returns the quantities of an order's items to stock.
+func restockOrderItems(tx *gorm.DB, orderID uint) error {
+	var orderItems []models.OrderItem
+	if err := tx.Where("order_id = ?", orderID).Find(&orderItems).Error; err != nil {
+		return err
+	}
+
+	for i := range orderItems {
+		if err := restock(tx, orderItems[i].ProductID, orderItems[i].VariantID, orderItems[i].Quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restock puts quantity back into the stock of the product, or of its variant
+// when variantID is set. Soft-deleted products and variants are restocked too
+// so inventory stays correct if they are restored.
+func restock(tx *gorm.DB, productID uint, variantID *uint, quantity int) error {
+	if variantID != nil {
+		return tx.Unscoped().Model(&models.ProductVariant{}).
+			Where("id = ?", *variantID).
+			Update("stock", gorm.Expr("stock + ?", quantity)).Error
+	}
+
+	return tx.Unscoped().Model(&models.Product{}).
+		Where("id = ?", productID).
+		Update("stock", gorm.Expr("stock + ?", quantity)).Error
+}
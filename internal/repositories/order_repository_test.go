@@ -0,0 +1,172 @@
+package repositories
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/tax"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openTestDB connects to the migrated Postgres database in TEST_DATABASE_DSN
+// and skips the test when it isn't set.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+	return db
+}
+
+// TestCreateOrderConcurrentStock checks out more carts than there is stock
+// for, all at once, and expects exactly as many orders as there was stock.
+func TestCreateOrderConcurrentStock(t *testing.T) {
+	db := openTestDB(t)
+
+	const (
+		stock     = 3
+		checkouts = 10
+	)
+	suffix := time.Now().UnixNano()
+
+	category := models.Category{
+		Name: "Concurrency",
+		Slug: fmt.Sprintf("concurrency-%d", suffix),
+	}
+	if err := db.Create(&category).Error; err != nil {
+		t.Fatalf("failed to create category: %v", err)
+	}
+	zone := models.ShippingZone{Name: fmt.Sprintf("Concurrency %d", suffix)}
+	if err := db.Create(&zone).Error; err != nil {
+		t.Fatalf("failed to create shipping zone: %v", err)
+	}
+
+	var users []models.User
+	t.Cleanup(func() {
+		// Orders, carts and their items go with the users, category and zone
+		for i := range users {
+			db.Unscoped().Delete(&users[i])
+		}
+		db.Unscoped().Delete(&category)
+		db.Unscoped().Delete(&zone)
+	})
+
+	method := models.ShippingMethod{
+		ZoneID: zone.ID,
+		Name:   "Standard Shipping",
+		Type:   models.ShippingMethodFlatRate,
+		Rate:   money.New(0),
+	}
+	if err := db.Create(&method).Error; err != nil {
+		t.Fatalf("failed to create shipping method: %v", err)
+	}
+
+	product := models.Product{
+		CategoryID: category.ID,
+		Name:       "Last Few",
+		Price:      money.New(1000),
+		Stock:      stock,
+		SKU:        fmt.Sprintf("CONCURRENCY-%d", suffix),
+	}
+	if err := db.Create(&product).Error; err != nil {
+		t.Fatalf("failed to create product: %v", err)
+	}
+
+	for i := 0; i < checkouts; i++ {
+		user := models.User{
+			Email:     fmt.Sprintf("concurrency-%d-%d@example.com", suffix, i),
+			Password:  "unused",
+			FirstName: "Test",
+			LastName:  "Buyer",
+		}
+		if err := db.Create(&user).Error; err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		users = append(users, user)
+
+		cart := models.Cart{
+			UserID:    &user.ID,
+			CartItems: []models.CartItem{{ProductID: product.ID, Quantity: 1}},
+		}
+		if err := db.Create(&cart).Error; err != nil {
+			t.Fatalf("failed to create cart: %v", err)
+		}
+	}
+
+	address := models.OrderAddress{
+		FirstName:  "Test",
+		LastName:   "Buyer",
+		Line1:      "1 Test Street",
+		City:       "Testville",
+		PostalCode: "12345",
+		Country:    "US",
+	}
+	params := CreateOrderParams{
+		ShippingAddress: address,
+		BillingAddress:  address,
+		ShippingMethod:  &method,
+		TaxCalculator:   tax.NewCalculator(nil, false),
+	}
+
+	repo := NewOrderRepository(db)
+	errs := make([]error, checkouts)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range users {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = repo.CreateOrder(users[i].ID, params)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !strings.Contains(err.Error(), "insufficient stock"):
+			t.Errorf("unexpected checkout error: %v", err)
+		}
+	}
+	if succeeded != stock {
+		t.Errorf("%d checkouts succeeded, want %d", succeeded, stock)
+	}
+
+	var remaining int
+	if err := db.Model(&models.Product{}).Where("id = ?", product.ID).Pluck("stock", &remaining).Error; err != nil {
+		t.Fatalf("failed to reload stock: %v", err)
+	}
+	if remaining != 0 {
+		t.Errorf("stock is %d after checkout, want 0", remaining)
+	}
+
+	var sold int
+	if err := db.Model(&models.OrderItem{}).Where("product_id = ?", product.ID).
+		Select("COALESCE(SUM(quantity), 0)").Scan(&sold).Error; err != nil {
+		t.Fatalf("failed to count sold items: %v", err)
+	}
+	if sold != stock {
+		t.Errorf("%d items were ordered, want %d", sold, stock)
+	}
+}
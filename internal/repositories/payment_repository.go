@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+)
+
+var _ PaymentRepositoryInterface = (*PaymentRepository)(nil)
+
+type PaymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+// Create implements PaymentRepositoryInterface.
+func (r *PaymentRepository) Create(payment *models.Payment) error {
+	return r.db.Create(payment).Error
+}
+
+// GetByTransactionID implements PaymentRepositoryInterface.
+func (r *PaymentRepository) GetByTransactionID(provider, transactionID string) (*models.Payment, error) {
+	var payment models.Payment
+	if err := r.db.Where("provider = ? AND transaction_id = ?", provider, transactionID).First(&payment).Error; err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// GetLatestByOrderID implements PaymentRepositoryInterface.
+// It returns nil without an error when the order has no payments.
+func (r *PaymentRepository) GetLatestByOrderID(orderID uint) (*models.Payment, error) {
+	var payments []models.Payment
+	if err := r.db.Where("order_id = ?", orderID).Order("id DESC").Limit(1).Find(&payments).Error; err != nil {
+		return nil, err
+	}
+
+	if len(payments) == 0 {
+		return nil, nil
+	}
+	return &payments[0], nil
+}
+
+// UpdateStatus implements PaymentRepositoryInterface.
+// Moves the payment status can't make are rejected. The update only applies
+// while the stored status still matches payment.Status and may still move to
+// status, so a duplicate or concurrent webhook can't apply the same change
+// twice. It reports whether the row was updated.
+func (r *PaymentRepository) UpdateStatus(payment *models.Payment, status models.PaymentStatus, failureReason string) (bool, error) {
+	if !payment.Status.CanTransitionTo(status) {
+		return false, fmt.Errorf("cannot move a %s payment to %s", payment.Status, status)
+	}
+
+	result := r.db.Model(&models.Payment{}).
+		Where("id = ? AND status = ? AND status IN ?", payment.ID, payment.Status, models.PaymentStatusesBefore(status)).
+		Updates(map[string]interface{}{
+			"status":         status,
+			"failure_reason": failureReason,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	payment.Status = status
+	payment.FailureReason = failureReason
+	return true, nil
+}
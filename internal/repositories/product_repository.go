@@ -1,8 +1,17 @@
 package repositories
 
 import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
 	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ ProductRepositoryInterface = (*ProductRepository)(nil)
@@ -17,9 +26,73 @@ func NewProductRepository(db *gorm.DB) *ProductRepository {
 	}
 }
 
-func (p *ProductRepository) CreateCategory(name, description string) (*models.Category, error) {
+// byPosition orders product options and their values as they were arranged.
+func byPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position, id")
+}
+
+// withProductVariants loads the options and variants of the products.
+func withProductVariants(db *gorm.DB) *gorm.DB {
+	return db.Preload("Options", byPosition).Preload("Options.Values", byPosition).
+		Preload("Variants", func(db *gorm.DB) *gorm.DB {
+			return withVariantReservedStock(db).Order("id")
+		}).
+		Preload("Variants.OptionValues")
+}
+
+// withProductDetails loads everything a product response shows for the
+// products at path, such as "CartItems.Product": their category, images,
+// options and variants.
+func withProductDetails(path string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Preload(path+".Category").Preload(path+".Images").
+			Preload(path+".Options", byPosition).Preload(path+".Options.Values", byPosition).
+			Preload(path+".Variants", func(db *gorm.DB) *gorm.DB {
+				return withVariantReservedStock(db).Order("id")
+			}).
+			Preload(path + ".Variants.OptionValues")
+	}
+}
+
+// categoryTreeSQL selects the IDs of a category and of all the categories
+// under it. UNION drops categories already selected, so it ends even if the
+// parents ever form a cycle.
+const categoryTreeSQL = `WITH RECURSIVE category_tree AS (
+	SELECT id FROM categories WHERE id = ? AND deleted_at IS NULL
+	UNION
+	SELECT c.id FROM categories c JOIN category_tree t ON c.parent_id = t.id WHERE c.deleted_at IS NULL
+) SELECT id FROM category_tree`
+
+// categoryPathSQL selects a category and its ancestors, top-level category first.
+// The categories visited so far are carried along, so the walk stops at the
+// first one seen twice rather than going round a cycle forever.
+const categoryPathSQL = `WITH RECURSIVE category_path AS (
+	SELECT id, parent_id, 0 AS depth, ARRAY[id] AS visited FROM categories WHERE id = ? AND deleted_at IS NULL
+	UNION ALL
+	SELECT c.id, c.parent_id, p.depth + 1, p.visited || c.id FROM categories c JOIN category_path p ON c.id = p.parent_id
+	WHERE c.deleted_at IS NULL AND NOT c.id = ANY(p.visited)
+)
+SELECT categories.* FROM categories JOIN category_path ON categories.id = category_path.id
+ORDER BY category_path.depth DESC`
+
+// whereCategory narrows a products query to the filter's category, and
+// the categories under it when IncludeSubcategories is set.
+func whereCategory(db *gorm.DB, filter ProductFilter) *gorm.DB {
+	switch {
+	case filter.CategoryID == nil:
+		return db
+	case filter.IncludeSubcategories:
+		return db.Where("products.category_id IN ("+categoryTreeSQL+")", *filter.CategoryID)
+	default:
+		return db.Where("products.category_id = ?", *filter.CategoryID)
+	}
+}
+
+func (p *ProductRepository) CreateCategory(parentID *uint, name, slug, description string) (*models.Category, error) {
 	category := models.Category{
+		ParentID:    parentID,
 		Name:        name,
+		Slug:        slug,
 		Description: description,
 	}
 
@@ -37,18 +110,78 @@ func (p *ProductRepository) GetCategoriesByID(id uint) (*models.Category, error)
 	return &category, nil
 }
 
+func (p *ProductRepository) GetCategoryBySlug(slug string) (*models.Category, error) {
+	var category models.Category
+	if err := p.db.Where("slug = ?", slug).First(&category).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
 func (p *ProductRepository) GetCategoriesByStatus(is_active bool) ([]models.Category, error) {
 
 	var categories []models.Category
-	if err := p.db.Where("is_active = ?", is_active).Find(&categories).Error; err != nil {
+	if err := p.db.Where("is_active = ?", is_active).Order("name, id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetChildCategoriesByStatus implements ProductRepositoryInterface.
+func (p *ProductRepository) GetChildCategoriesByStatus(parentID uint, isActive bool) ([]models.Category, error) {
+	var categories []models.Category
+	if err := p.db.Where("parent_id = ? AND is_active = ?", parentID, isActive).Order("name, id").Find(&categories).Error; err != nil {
 		return nil, err
 	}
 	return categories, nil
 }
 
-func (p *ProductRepository) UpdateCategory(category *models.Category) error {
+func (p *ProductRepository) GetChildCategoryCount(parentID uint) (int64, error) {
+	var count int64
+	if err := p.db.Model(&models.Category{}).Where("parent_id = ?", parentID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetCategoryPath implements ProductRepositoryInterface.
+func (p *ProductRepository) GetCategoryPath(id uint) ([]models.Category, error) {
+	return categoryPath(p.db, id)
+}
+
+// categoryPath returns the category with id and its ancestors, top-level
+// category first, or gorm.ErrRecordNotFound when there is no such category.
+func categoryPath(db *gorm.DB, id uint) ([]models.Category, error) {
+	var categories []models.Category
+	if err := db.Raw(categoryPathSQL, id).Scan(&categories).Error; err != nil {
+		return nil, err
+	}
+	if len(categories) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return categories, nil
+}
 
-	return p.db.Save(category).Error
+// UpdateCategory implements ProductRepositoryInterface.
+func (p *ProductRepository) UpdateCategory(category *models.Category, checkParent func(path []models.Category) error) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		if category.ParentID != nil {
+			// Hold off other writes to categories until the move is saved, so
+			// two concurrent moves can't each pass the check and form a cycle
+			if err := tx.Exec("LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE").Error; err != nil {
+				return err
+			}
+			path, err := categoryPath(tx, *category.ParentID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if err := checkParent(path); err != nil {
+				return err
+			}
+		}
+
+		return tx.Save(category).Error
+	})
 }
 
 func (p *ProductRepository) DeleteCategory(id uint) error {
@@ -56,7 +189,7 @@ func (p *ProductRepository) DeleteCategory(id uint) error {
 
 }
 
-func (p *ProductRepository) CreateProduct(categoryID uint, name string, description string, price float64, stock int, sku string) (*models.Product, error) {
+func (p *ProductRepository) CreateProduct(categoryID uint, name string, description string, price money.Money, stock int, sku string, taxClass string, weightGrams int) (*models.Product, error) {
 	product := models.Product{
 		CategoryID:  categoryID,
 		Name:        name,
@@ -64,6 +197,8 @@ func (p *ProductRepository) CreateProduct(categoryID uint, name string, descript
 		Price:       price,
 		Stock:       stock,
 		SKU:         sku,
+		TaxClass:    taxClass,
+		WeightGrams: weightGrams,
 	}
 
 	if err := p.db.Create(&product).Error; err != nil {
@@ -77,17 +212,23 @@ func (p *ProductRepository) CreateProduct(categoryID uint, name string, descript
 func (p *ProductRepository) GetProductByID(id uint) (*models.Product, error) {
 	var product models.Product
 
-	if err := p.db.Preload("Category").Preload("Images").First(&product, id).Error; err != nil {
+	if err := p.db.Scopes(withReservedStock, withProductVariants).Preload("Category").Preload("Images").First(&product, id).Error; err != nil {
 		return nil, err
 	}
 
 	return &product, nil
 }
 
-func (p *ProductRepository) GetProductsByStatus(is_active bool, offset, limit int) ([]models.Product, error) {
+func (p *ProductRepository) GetProductsByStatus(is_active bool, filter ProductFilter, offset, limit int) ([]models.Product, error) {
 	var products []models.Product
-	if err := p.db.Preload("Category").Preload("Images").
+	order, ok := productSortOrders[filter.Sort]
+	if !ok || filter.Sort == "relevance" {
+		order = productSortOrders["newest"]
+	}
+
+	if err := whereCategory(p.db.Scopes(withReservedStock, withProductVariants), filter).Preload("Category").Preload("Images").
 		Where("is_active = ?", true).
+		Order(order).
 		Offset(offset).Limit(limit).
 		Find(&products).Error; err != nil {
 		return nil, err
@@ -95,9 +236,9 @@ func (p *ProductRepository) GetProductsByStatus(is_active bool, offset, limit in
 	return products, nil
 }
 
-func (p *ProductRepository) GetProductsCountByStatus(is_active bool) (int64, error) {
+func (p *ProductRepository) GetProductsCountByStatus(is_active bool, filter ProductFilter) (int64, error) {
 	var total int64
-	if err := p.db.Model(&models.Product{}).Where("is_active = ?", true).Count(&total).Error; err != nil {
+	if err := whereCategory(p.db.Model(&models.Product{}), filter).Where("is_active = ?", true).Count(&total).Error; err != nil {
 		return 0, err
 	}
 	return int64(total), nil
@@ -111,9 +252,10 @@ func (p *ProductRepository) DeleteProduct(id uint) error {
 	return p.db.Delete(&models.Product{}, id).Error
 
 }
-func (p *ProductRepository) AddProductImages(productID uint, url string, altText string, isPrimary bool) error {
+func (p *ProductRepository) AddProductImages(productID uint, variantID *uint, url string, altText string, isPrimary bool) error {
 	image := models.ProductImage{
 		ProductID: productID,
+		VariantID: variantID,
 		URL:       url,
 		AltText:   altText,
 		IsPrimary: isPrimary,
@@ -122,6 +264,57 @@ func (p *ProductRepository) AddProductImages(productID uint, url string, altText
 	return p.db.Create(&image).Error
 
 }
+
+// CreateProductOption implements ProductRepositoryInterface.
+func (p *ProductRepository) CreateProductOption(option *models.ProductOption) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		// New options go after the product's existing ones
+		var count int64
+		if err := tx.Model(&models.ProductOption{}).Where("product_id = ?", option.ProductID).Count(&count).Error; err != nil {
+			return err
+		}
+		option.Position = int(count)
+
+		return tx.Create(option).Error
+	})
+}
+
+// GetProductVariant implements ProductRepositoryInterface.
+func (p *ProductRepository) GetProductVariant(productID, variantID uint) (*models.ProductVariant, error) {
+	var variant models.ProductVariant
+	if err := p.db.Scopes(withVariantReservedStock).Preload("OptionValues").
+		Where("id = ? AND product_id = ?", variantID, productID).First(&variant).Error; err != nil {
+		return nil, err
+	}
+	return &variant, nil
+}
+
+// CreateProductVariant implements ProductRepositoryInterface.
+// The variant's option values must already exist; only the links to them are created.
+func (p *ProductRepository) CreateProductVariant(variant *models.ProductVariant) error {
+	return p.db.Omit("OptionValues.*").Create(variant).Error
+}
+
+// UpdateProductVariant implements ProductRepositoryInterface.
+func (p *ProductRepository) UpdateProductVariant(variant *models.ProductVariant) error {
+	return p.db.Omit(clause.Associations).Save(variant).Error
+}
+
+// DeleteProductVariant implements ProductRepositoryInterface.
+func (p *ProductRepository) DeleteProductVariant(productID, variantID uint) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		// The variant is only soft deleted, so carts would otherwise keep it
+		if err := tx.Where("variant_id = ?", variantID).Delete(&models.StockReservation{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("variant_id = ?", variantID).Delete(&models.CartItem{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ? AND product_id = ?", variantID, productID).Delete(&models.ProductVariant{}).Error
+	})
+}
+
 func (p *ProductRepository) GetProductImageCount(productID uint) (int64, error) {
 	var count int64
 	if err := p.db.Model(&models.ProductImage{}).Where("product_id = ?", productID).Count(&count).Error; err != nil {
@@ -131,37 +324,385 @@ func (p *ProductRepository) GetProductImageCount(productID uint) (int64, error)
 
 }
 
-func (p *ProductRepository) SearchProducts(queryString string, categoryID *uint, minPrice *float64, maxPrice *float64, offset int, limit int) ([]models.ProductsWithRank, *int64, error) {
-	query := p.db.Model(&models.Product{}).
-		Select("products.*, ts_rank(search_vector, plainto_tsquery('english', ?)) as rank", queryString).
-		Where("search_vector @@ plainto_tsquery('english', ?)", queryString).
-		Where("is_active = ?", true)
-
-	if categoryID != nil {
-		query = query.Where("category_id = ?", categoryID)
+// SearchProducts implements ProductRepositoryInterface. The facets and the
+// total are counted together in one query.
+func (p *ProductRepository) SearchProducts(search ProductSearch, offset int, limit int) ([]models.ProductsWithRank, *models.ProductFacets, error) {
+	tsQuery, err := p.searchTSQuery(search.Query)
+	if err != nil {
+		return nil, nil, err
 	}
+	conditions := searchConditionsOf(search)
 
-	if minPrice != nil {
-		query = query.Where("price >= ?", minPrice)
+	order, ok := productSortOrders[search.Sort]
+	if !ok {
+		order = productSortOrders["relevance"]
 	}
 
-	if maxPrice != nil {
-		query = query.Where("price <= ?", maxPrice)
-	}
+	query := p.db.Model(&models.Product{}).
+		Select("products.*, "+reservedStockSQL+" AS reserved_stock, ts_rank(search_vector, "+tsQuery.sql+") as rank", tsQuery.args...).
+		Where("search_vector @@ ("+tsQuery.sql+")", tsQuery.args...).
+		Where("is_active = ?", true)
 
-	// Count total results
-	var total int64
-	query.Count(&total)
+	for _, condition := range conditions.all() {
+		query = query.Where(condition.sql, condition.args...)
+	}
 
 	var rows []models.ProductsWithRank
 	if err := query.
-		Order("rank DESC, created_at DESC"). // order by relevance
+		Order(order).
 		Preload("Category").
 		Preload("Images").
+		Scopes(withProductVariants).
 		Offset(offset).
 		Limit(limit).
 		Find(&rows).Error; err != nil {
 		return nil, nil, err
 	}
-	return rows, &total, nil
+
+	facets, err := p.searchFacets(tsQuery, conditions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, facets, nil
+}
+
+// popularitySQL is the number of units of a product sold in orders that
+// weren't cancelled.
+const popularitySQL = "(SELECT COALESCE(SUM(order_items.quantity), 0) FROM order_items " +
+	"JOIN orders ON orders.id = order_items.order_id " +
+	"WHERE order_items.product_id = products.id AND order_items.deleted_at IS NULL " +
+	"AND orders.deleted_at IS NULL AND orders.status <> 'cancelled')"
+
+// productSortOrders maps the sort keys of ProductFilter to the order of the
+// products. "relevance" is the rank of a search, so listings don't use it.
+var productSortOrders = map[string]string{
+	"relevance":  "rank DESC, products.created_at DESC, products.id DESC",
+	"newest":     "products.created_at DESC, products.id DESC",
+	"price_asc":  "products.price, products.id",
+	"price_desc": "products.price DESC, products.id",
+	"popularity": popularitySQL + " DESC, products.id DESC",
+}
+
+// searchTSQuery returns the tsquery products match a search query by. When
+// words of the query have synonyms, each word must match either as written
+// or as one of its synonyms.
+func (p *ProductRepository) searchTSQuery(query string) (sqlCondition, error) {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	var synonyms []models.SearchSynonym
+	if err := p.db.Where("term IN ?", words).Order("id").Find(&synonyms).Error; err != nil {
+		return sqlCondition{}, err
+	}
+	if len(synonyms) == 0 {
+		return sqlCondition{sql: "plainto_tsquery('english', ?)", args: []any{query}}, nil
+	}
+
+	var terms []string
+	var args []any
+	for _, word := range words {
+		alternatives := []string{"plainto_tsquery('english', ?)"}
+		args = append(args, word)
+		for i := range synonyms {
+			if synonyms[i].Term == word {
+				alternatives = append(alternatives, "plainto_tsquery('english', ?)")
+				args = append(args, synonyms[i].Synonym)
+			}
+		}
+		terms = append(terms, "("+strings.Join(alternatives, " || ")+")")
+	}
+
+	return sqlCondition{sql: strings.Join(terms, " && "), args: args}, nil
+}
+
+// priceFacetBounds are where the price ranges of the search facets start
+// and end.
+var priceFacetBounds = []money.Money{
+	money.MustParse("25"),
+	money.MustParse("50"),
+	money.MustParse("100"),
+	money.MustParse("250"),
+	money.MustParse("500"),
+}
+
+// inStockSQL is true for products with stock left that isn't reserved, or
+// for products sold as variants, when one of their active variants has.
+const inStockSQL = "(CASE WHEN EXISTS (SELECT 1 FROM product_variants " +
+	"WHERE product_variants.product_id = products.id AND product_variants.deleted_at IS NULL) " +
+	"THEN EXISTS (SELECT 1 FROM product_variants WHERE product_variants.product_id = products.id " +
+	"AND product_variants.deleted_at IS NULL AND product_variants.is_active " +
+	"AND product_variants.stock > " + variantReservedStockSQL + ") " +
+	"ELSE products.stock > " + reservedStockSQL + " END)"
+
+// attributeSQL is true for products with an active variant that has one of
+// the given values of an option. Both are compared lowercased.
+const attributeSQL = "EXISTS (SELECT 1 FROM product_variants v " +
+	"JOIN product_variant_option_values vov ON vov.variant_id = v.id " +
+	"JOIN product_option_values ov ON ov.id = vov.option_value_id " +
+	"JOIN product_options o ON o.id = ov.option_id " +
+	"WHERE v.product_id = products.id AND v.deleted_at IS NULL AND v.is_active " +
+	"AND lower(o.name) = ? AND lower(ov.value) IN ?)"
+
+type sqlCondition struct {
+	sql  string
+	args []any
+}
+
+// searchConditions are the filters of a product search by the facet they
+// narrow. Filters that weren't chosen are nil.
+type searchConditions struct {
+	category   *sqlCondition
+	price      *sqlCondition
+	stock      *sqlCondition
+	attributes []sqlCondition
+	// options holds the lowercased option name of each attribute filter
+	options []string
+}
+
+func searchConditionsOf(search ProductSearch) searchConditions {
+	var conditions searchConditions
+
+	if search.CategoryID != nil {
+		conditions.category = &sqlCondition{sql: "products.category_id = ?", args: []any{*search.CategoryID}}
+		if search.IncludeSubcategories {
+			conditions.category.sql = "products.category_id IN (" + categoryTreeSQL + ")"
+		}
+	}
+
+	switch {
+	case search.MinPrice != nil && search.MaxPrice != nil:
+		conditions.price = &sqlCondition{sql: "products.price >= ? AND products.price <= ?", args: []any{*search.MinPrice, *search.MaxPrice}}
+	case search.MinPrice != nil:
+		conditions.price = &sqlCondition{sql: "products.price >= ?", args: []any{*search.MinPrice}}
+	case search.MaxPrice != nil:
+		conditions.price = &sqlCondition{sql: "products.price <= ?", args: []any{*search.MaxPrice}}
+	}
+
+	if search.InStock != nil {
+		conditions.stock = &sqlCondition{sql: inStockSQL + " = ?", args: []any{*search.InStock}}
+	}
+
+	for _, attribute := range search.Attributes {
+		option := strings.ToLower(attribute.Option)
+		values := make([]string, len(attribute.Values))
+		for i := range attribute.Values {
+			values[i] = strings.ToLower(attribute.Values[i])
+		}
+		conditions.attributes = append(conditions.attributes, sqlCondition{sql: attributeSQL, args: []any{option, values}})
+		conditions.options = append(conditions.options, option)
+	}
+
+	return conditions
+}
+
+// all returns the chosen filters.
+func (c searchConditions) all() []sqlCondition {
+	var all []sqlCondition
+	for _, condition := range []*sqlCondition{c.category, c.price, c.stock} {
+		if condition != nil {
+			all = append(all, *condition)
+		}
+	}
+	return append(all, c.attributes...)
+}
+
+// facetRow is one count of the facets query. ID is the category ID, the
+// price range index or 1 for in stock; Name and Value are the option and
+// value of an attribute, or the category name.
+type facetRow struct {
+	Facet string
+	ID    int64
+	Name  string
+	Value string
+	Count int64
+}
+
+// searchFacets counts the products matching tsQuery by facet. The matching
+// products are selected once along with which filter each passes, and every
+// facet is counted over them with all filters but its own.
+func (p *ProductRepository) searchFacets(tsQuery sqlCondition, conditions searchConditions) (*models.ProductFacets, error) {
+	var args []any
+	column := func(condition *sqlCondition, name string) string {
+		if condition == nil {
+			return "TRUE AS " + name
+		}
+		args = append(args, condition.args...)
+		return "(" + condition.sql + ") AS " + name
+	}
+
+	columns := []string{
+		"products.id", "products.category_id", "products.price", inStockSQL + " AS in_stock",
+		column(conditions.category, "in_category"),
+		column(conditions.price, "in_price"),
+		column(conditions.stock, "in_stock_filter"),
+	}
+	attributeColumns := make([]string, len(conditions.attributes))
+	for i := range conditions.attributes {
+		attributeColumns[i] = fmt.Sprintf("has_attribute_%d", i)
+		columns = append(columns, column(&conditions.attributes[i], attributeColumns[i]))
+	}
+	args = append(args, tsQuery.args...)
+
+	matched := "WITH matched AS (SELECT " + strings.Join(columns, ", ") + " FROM products " +
+		"WHERE products.deleted_at IS NULL AND products.is_active " +
+		"AND products.search_vector @@ (" + tsQuery.sql + "))"
+
+	// where joins the filters of every facet but the excluded ones
+	where := func(excluded ...string) string {
+		filters := []string{"TRUE"}
+		for _, name := range append([]string{"in_category", "in_price", "in_stock_filter"}, attributeColumns...) {
+			if !slices.Contains(excluded, name) {
+				filters = append(filters, "m."+name)
+			}
+		}
+		return strings.Join(filters, " AND ")
+	}
+
+	priceRange := "CASE"
+	for i, bound := range priceFacetBounds {
+		priceRange += fmt.Sprintf(" WHEN m.price < ? THEN %d", i)
+		args = append(args, bound)
+	}
+	priceRange += fmt.Sprintf(" ELSE %d END", len(priceFacetBounds))
+
+	// Each option's values are counted without that option's own filter
+	attributeFilters := []string{where(attributeColumns...)}
+	for i, option := range conditions.options {
+		attributeFilters = append(attributeFilters, "(lower(o.name) = ? OR m."+attributeColumns[i]+")")
+		args = append(args, option)
+	}
+
+	sql := matched + `
+SELECT 'total' AS facet, 0 AS id, '' AS name, '' AS value, count(*) AS count
+  FROM matched m WHERE ` + where() + `
+UNION ALL
+SELECT 'category', m.category_id, c.name, '', count(*)
+  FROM matched m JOIN categories c ON c.id = m.category_id
+ WHERE ` + where("in_category") + `
+ GROUP BY m.category_id, c.name
+UNION ALL
+SELECT 'price', ` + priceRange + `, '', '', count(*)
+  FROM matched m WHERE ` + where("in_price") + `
+ GROUP BY 2
+UNION ALL
+SELECT 'stock', CASE WHEN m.in_stock THEN 1 ELSE 0 END, '', '', count(*)
+  FROM matched m WHERE ` + where("in_stock_filter") + `
+ GROUP BY 2
+UNION ALL
+SELECT 'attribute', 0, o.name, ov.value, count(DISTINCT m.id)
+  FROM matched m
+  JOIN product_variants v ON v.product_id = m.id AND v.deleted_at IS NULL AND v.is_active
+  JOIN product_variant_option_values vov ON vov.variant_id = v.id
+  JOIN product_option_values ov ON ov.id = vov.option_value_id
+  JOIN product_options o ON o.id = ov.option_id
+ WHERE ` + strings.Join(attributeFilters, " AND ") + `
+ GROUP BY o.name, ov.value`
+
+	var rows []facetRow
+	if err := p.db.Raw(sql, args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	facets := &models.ProductFacets{
+		Categories:  []models.CategoryFacet{},
+		PriceRanges: make([]models.PriceRangeFacet, len(priceFacetBounds)+1),
+		Attributes:  []models.AttributeFacet{},
+	}
+	for i := range facets.PriceRanges {
+		if i > 0 {
+			facets.PriceRanges[i].Min = priceFacetBounds[i-1]
+		} else {
+			facets.PriceRanges[i].Min = money.New(0)
+		}
+		if i < len(priceFacetBounds) {
+			bound := priceFacetBounds[i]
+			facets.PriceRanges[i].Max = &bound
+		}
+	}
+
+	for _, row := range rows {
+		switch row.Facet {
+		case "total":
+			facets.Total = row.Count
+		case "category":
+			facets.Categories = append(facets.Categories, models.CategoryFacet{CategoryID: uint(row.ID), Name: row.Name, Count: row.Count})
+		case "price":
+			facets.PriceRanges[row.ID].Count = row.Count
+		case "stock":
+			if row.ID == 1 {
+				facets.InStock = row.Count
+			} else {
+				facets.OutOfStock = row.Count
+			}
+		case "attribute":
+			facets.Attributes = append(facets.Attributes, models.AttributeFacet{Option: row.Name, Value: row.Value, Count: row.Count})
+		}
+	}
+
+	slices.SortFunc(facets.Categories, func(a, b models.CategoryFacet) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+	slices.SortFunc(facets.Attributes, func(a, b models.AttributeFacet) int {
+		return cmp.Or(cmp.Compare(a.Option, b.Option), cmp.Compare(b.Count, a.Count), cmp.Compare(a.Value, b.Value))
+	})
+
+	return facets, nil
+}
+
+// suggestSQL selects the active products and categories whose names start
+// with the query or have a word that does, followed by those with a word
+// resembling it, which catches misspellings. Both kinds of match are served
+// by the trigram indexes on the lowercased names.
+const suggestSQL = `(SELECT 'product' AS kind, id, name, '' AS slug
+  FROM products
+ WHERE deleted_at IS NULL AND is_active
+   AND (lower(name) LIKE @prefix OR lower(name) LIKE @word OR @query <% lower(name))
+ ORDER BY lower(name) LIKE @prefix DESC, lower(name) LIKE @word DESC, word_similarity(@query, lower(name)) DESC, name
+ LIMIT @limit)
+UNION ALL
+(SELECT 'category', id, name, slug
+  FROM categories
+ WHERE deleted_at IS NULL AND is_active
+   AND (lower(name) LIKE @prefix OR lower(name) LIKE @word OR @query <% lower(name))
+ ORDER BY lower(name) LIKE @prefix DESC, lower(name) LIKE @word DESC, word_similarity(@query, lower(name)) DESC, name
+ LIMIT @limit)`
+
+// likeEscaper escapes the LIKE wildcards in a search query.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SuggestSearch implements ProductRepositoryInterface.
+func (p *ProductRepository) SuggestSearch(query string, limit int) ([]models.SearchSuggestion, error) {
+	query = strings.ToLower(query)
+	pattern := likeEscaper.Replace(query) + "%"
+
+	var suggestions []models.SearchSuggestion
+	err := p.db.Raw(suggestSQL, map[string]any{
+		"query":  query,
+		"prefix": pattern,
+		"word":   "% " + pattern,
+		"limit":  limit,
+	}).Scan(&suggestions).Error
+
+	return suggestions, err
+}
+
+// GetSearchSynonyms implements ProductRepositoryInterface.
+func (p *ProductRepository) GetSearchSynonyms() ([]models.SearchSynonym, error) {
+	var synonyms []models.SearchSynonym
+	if err := p.db.Order("term, synonym").Find(&synonyms).Error; err != nil {
+		return nil, err
+	}
+	return synonyms, nil
+}
+
+// CreateSearchSynonym implements ProductRepositoryInterface.
+func (p *ProductRepository) CreateSearchSynonym(synonym *models.SearchSynonym) error {
+	return p.db.Create(synonym).Error
+}
+
+// DeleteSearchSynonym implements ProductRepositoryInterface.
+func (p *ProductRepository) DeleteSearchSynonym(id uint) error {
+	return p.db.Delete(&models.SearchSynonym{}, id).Error
 }
@@ -0,0 +1,327 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ ReturnRepositoryInterface = (*ReturnRepository)(nil)
+
+type ReturnRepository struct {
+	db *gorm.DB
+}
+
+func NewReturnRepository(db *gorm.DB) *ReturnRepository {
+	return &ReturnRepository{db: db}
+}
+
+// Create implements ReturnRepositoryInterface.
+// The order is locked while the return is checked and written, so concurrent
+// requests can't return more of an item than was delivered.
+func (r *ReturnRepository) Create(userID, orderID uint, reason string, items []models.ReturnItem) (*models.ReturnRequest, error) {
+	var returnResponse *models.ReturnRequest
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var order models.Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", orderID, userID).
+			First(&order).Error; err != nil {
+			return errors.New("order not found")
+		}
+
+		if order.Status != models.OrderStatusDelivered {
+			return errors.New("only delivered orders can be returned")
+		}
+
+		var orderItems []models.OrderItem
+		if err := tx.Where("order_id = ?", orderID).Find(&orderItems).Error; err != nil {
+			return err
+		}
+
+		returnable := make(map[uint]int, len(orderItems))
+		for i := range orderItems {
+			returnable[orderItems[i].ID] = orderItems[i].Quantity
+		}
+
+		returned, err := returnedQuantities(tx, orderID)
+		if err != nil {
+			return err
+		}
+
+		requested := make(map[uint]bool, len(items))
+		for i := range items {
+			orderItemID := items[i].OrderItemID
+			quantity, ok := returnable[orderItemID]
+			if !ok {
+				return fmt.Errorf("order item %d is not part of the order", orderItemID)
+			}
+			if requested[orderItemID] {
+				return fmt.Errorf("order item %d is listed more than once", orderItemID)
+			}
+			requested[orderItemID] = true
+
+			if items[i].Quantity > quantity-returned[orderItemID] {
+				return fmt.Errorf("only %d of order item %d can be returned", quantity-returned[orderItemID], orderItemID)
+			}
+		}
+
+		returnRequest := models.ReturnRequest{
+			OrderID:      orderID,
+			UserID:       userID,
+			Status:       models.ReturnStatusRequested,
+			Reason:       reason,
+			RefundAmount: money.New(0),
+			Items:        items,
+		}
+		if err := tx.Omit("Items.OrderItem").Create(&returnRequest).Error; err != nil {
+			return err
+		}
+
+		if err := preloadReturn(tx).First(&returnRequest, returnRequest.ID).Error; err != nil {
+			return err
+		}
+		returnResponse = &returnRequest
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return returnResponse, nil
+}
+
+// returnedQuantities sums the quantity of each order item already in a return
+// that wasn't rejected.
+func returnedQuantities(tx *gorm.DB, orderID uint) (map[uint]int, error) {
+	var rows []struct {
+		OrderItemID uint
+		Quantity    int
+	}
+	if err := tx.Model(&models.ReturnItem{}).
+		Select("return_items.order_item_id, SUM(return_items.quantity) AS quantity").
+		Joins("JOIN return_requests ON return_requests.id = return_items.return_request_id").
+		Where("return_requests.order_id = ? AND return_requests.status <> ? AND return_requests.deleted_at IS NULL",
+			orderID, models.ReturnStatusRejected).
+		Group("return_items.order_item_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	returned := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		returned[row.OrderItemID] = row.Quantity
+	}
+	return returned, nil
+}
+
+func preloadReturn(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items.OrderItem").Preload("Order")
+}
+
+// GetByID implements ReturnRepositoryInterface.
+func (r *ReturnRepository) GetByID(returnID uint) (*models.ReturnRequest, error) {
+	var returnRequest models.ReturnRequest
+	if err := preloadReturn(r.db).First(&returnRequest, returnID).Error; err != nil {
+		return nil, err
+	}
+	return &returnRequest, nil
+}
+
+// GetByUserIDAndID implements ReturnRepositoryInterface.
+func (r *ReturnRepository) GetByUserIDAndID(userID, returnID uint) (*models.ReturnRequest, error) {
+	var returnRequest models.ReturnRequest
+	if err := preloadReturn(r.db).
+		Where("id = ? AND user_id = ?", returnID, userID).
+		First(&returnRequest).Error; err != nil {
+		return nil, err
+	}
+	return &returnRequest, nil
+}
+
+// GetByUserID implements ReturnRepositoryInterface.
+func (r *ReturnRepository) GetByUserID(userID uint, offset, limit int) ([]models.ReturnRequest, error) {
+	var returns []models.ReturnRequest
+	if err := preloadReturn(r.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&returns).Error; err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
+
+// GetCountByUserID implements ReturnRepositoryInterface.
+func (r *ReturnRepository) GetCountByUserID(userID uint) (int64, error) {
+	var total int64
+	if err := r.db.Model(&models.ReturnRequest{}).Where("user_id = ?", userID).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// GetReturns implements ReturnRepositoryInterface.
+// An empty status returns returns in every status.
+func (r *ReturnRepository) GetReturns(status models.ReturnStatus, offset, limit int) ([]models.ReturnRequest, error) {
+	var returns []models.ReturnRequest
+	if err := filterReturns(preloadReturn(r.db), status).
+		Order("created_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&returns).Error; err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
+
+// GetReturnsCount implements ReturnRepositoryInterface.
+func (r *ReturnRepository) GetReturnsCount(status models.ReturnStatus) (int64, error) {
+	var total int64
+	if err := filterReturns(r.db.Model(&models.ReturnRequest{}), status).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func filterReturns(db *gorm.DB, status models.ReturnStatus) *gorm.DB {
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	return db
+}
+
+// UpdateStatus implements ReturnRepositoryInterface.
+// It returns the return together with the status it had before. The return
+// is locked for the duration of the transaction. Receiving a return puts its
+// items back in stock. Returns are only refunded through Refund, which pays
+// the refund out, so refunded is rejected here.
+func (r *ReturnRepository) UpdateStatus(returnID uint, status models.ReturnStatus, rejectionReason string) (*models.ReturnRequest, models.ReturnStatus, error) {
+	if status == models.ReturnStatusRefunded {
+		return nil, "", errors.New("returns are refunded through Refund")
+	}
+
+	var (
+		returnResponse *models.ReturnRequest
+		previousStatus models.ReturnStatus
+	)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var returnRequest models.ReturnRequest
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("Items.OrderItem").
+			First(&returnRequest, returnID).Error; err != nil {
+			return errors.New("return not found")
+		}
+
+		if !returnRequest.Status.CanTransitionTo(status) {
+			return fmt.Errorf("cannot change return status from %s to %s", returnRequest.Status, status)
+		}
+
+		switch status {
+		case models.ReturnStatusReceived:
+			if err := restockReturnItems(tx, returnRequest.Items); err != nil {
+				return err
+			}
+		case models.ReturnStatusRejected:
+			returnRequest.RejectionReason = rejectionReason
+		}
+
+		previousStatus = returnRequest.Status
+		returnRequest.MarkStatus(status, time.Now())
+		if err := tx.Omit(clause.Associations).Save(&returnRequest).Error; err != nil {
+			return err
+		}
+
+		if err := preloadReturn(tx).First(&returnRequest, returnRequest.ID).Error; err != nil {
+			return err
+		}
+		returnResponse = &returnRequest
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return returnResponse, previousStatus, nil
+}
+
+// Refund implements ReturnRepositoryInterface.
+// The return and its order stay locked while refund pays out, so concurrent
+// refunds of the return, or of other returns of the order, wait for it
+// instead of paying out again.
+func (r *ReturnRepository) Refund(returnID uint, refund func(returnRequest *models.ReturnRequest) (money.Money, error)) (*models.ReturnRequest, models.ReturnStatus, error) {
+	var (
+		returnResponse *models.ReturnRequest
+		previousStatus models.ReturnStatus
+	)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var returnRequest models.ReturnRequest
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("Items.OrderItem").
+			First(&returnRequest, returnID).Error; err != nil {
+			return errors.New("return not found")
+		}
+
+		if !returnRequest.Status.CanTransitionTo(models.ReturnStatusRefunded) {
+			return fmt.Errorf("cannot change return status from %s to %s", returnRequest.Status, models.ReturnStatusRefunded)
+		}
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&returnRequest.Order, returnRequest.OrderID).Error; err != nil {
+			return errors.New("order not found")
+		}
+
+		amount, err := refund(&returnRequest)
+		if err != nil {
+			return err
+		}
+
+		if err := recordRefund(tx, returnRequest.OrderID, amount); err != nil {
+			return err
+		}
+		returnRequest.RefundAmount = amount
+
+		previousStatus = returnRequest.Status
+		returnRequest.MarkStatus(models.ReturnStatusRefunded, time.Now())
+		if err := tx.Omit(clause.Associations).Save(&returnRequest).Error; err != nil {
+			return err
+		}
+
+		if err := preloadReturn(tx).First(&returnRequest, returnRequest.ID).Error; err != nil {
+			return err
+		}
+		returnResponse = &returnRequest
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return returnResponse, previousStatus, nil
+}
+
+// restockReturnItems puts the returned quantities back into stock.
+func restockReturnItems(tx *gorm.DB, items []models.ReturnItem) error {
+	for i := range items {
+		orderItem := &items[i].OrderItem
+		if err := restock(tx, orderItem.ProductID, orderItem.VariantID, items[i].Quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordRefund adds amount to the order's refunded amount, refusing refunds
+// beyond what is left of the order total.
+func recordRefund(tx *gorm.DB, orderID uint, amount money.Money) error {
+	var order models.Order
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderID).Error; err != nil {
+		return errors.New("order not found")
+	}
+
+	remaining := order.TotalAmount.Sub(order.RefundedAmount)
+	if amount.Cmp(remaining) > 0 {
+		return fmt.Errorf("refund exceeds the %s left to refund on the order", remaining)
+	}
+
+	return tx.Model(&order).Update("refunded_amount", order.RefundedAmount.Add(amount)).Error
+}
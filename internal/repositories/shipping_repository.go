@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+)
+
+var _ ShippingRepositoryInterface = (*ShippingRepository)(nil)
+
+type ShippingRepository struct {
+	db *gorm.DB
+}
+
+func NewShippingRepository(db *gorm.DB) *ShippingRepository {
+	return &ShippingRepository{db: db}
+}
+
+// GetZones implements ShippingRepositoryInterface.
+func (r *ShippingRepository) GetZones() ([]models.ShippingZone, error) {
+	var zones []models.ShippingZone
+	if err := r.db.Preload("Locations").
+		Preload("Methods", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Order("id").
+		Find(&zones).Error; err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+// GetZoneByID implements ShippingRepositoryInterface.
+func (r *ShippingRepository) GetZoneByID(id uint) (*models.ShippingZone, error) {
+	var zone models.ShippingZone
+	if err := r.db.Preload("Locations").Preload("Methods").First(&zone, id).Error; err != nil {
+		return nil, err
+	}
+	return &zone, nil
+}
+
+// CreateZone implements ShippingRepositoryInterface.
+func (r *ShippingRepository) CreateZone(zone *models.ShippingZone) error {
+	return r.db.Create(zone).Error
+}
+
+// CreateMethod implements ShippingRepositoryInterface.
+func (r *ShippingRepository) CreateMethod(method *models.ShippingMethod) error {
+	return r.db.Omit("Zone").Create(method).Error
+}
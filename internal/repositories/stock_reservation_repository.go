@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ StockReservationRepositoryInterface = (*StockReservationRepository)(nil)
+
+// reservedStockSQL sums the stock held for a product by active reservations.
+// Reservations of its variants are counted against the variants instead.
+const reservedStockSQL = "COALESCE((SELECT SUM(stock_reservations.quantity) FROM stock_reservations " +
+	"WHERE stock_reservations.product_id = products.id AND stock_reservations.variant_id IS NULL " +
+	"AND stock_reservations.expires_at > NOW()), 0)"
+
+// variantReservedStockSQL sums the stock held for a variant by active reservations.
+const variantReservedStockSQL = "COALESCE((SELECT SUM(stock_reservations.quantity) FROM stock_reservations " +
+	"WHERE stock_reservations.variant_id = product_variants.id AND stock_reservations.expires_at > NOW()), 0)"
+
+// withReservedStock loads Product.ReservedStock along with the products.
+func withReservedStock(db *gorm.DB) *gorm.DB {
+	return db.Select("products.*, " + reservedStockSQL + " AS reserved_stock")
+}
+
+// withVariantReservedStock loads ProductVariant.ReservedStock along with the variants.
+func withVariantReservedStock(db *gorm.DB) *gorm.DB {
+	return db.Select("product_variants.*, " + variantReservedStockSQL + " AS reserved_stock")
+}
+
+type StockReservationRepository struct {
+	db *gorm.DB
+}
+
+func NewStockReservationRepository(db *gorm.DB) *StockReservationRepository {
+	return &StockReservationRepository{db: db}
+}
+
+// ReserveCart implements StockReservationRepositoryInterface.
+func (r *StockReservationRepository) ReserveCart(cartID uint, expiresAt time.Time) ([]models.StockReservation, error) {
+	var reservations []models.StockReservation
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var cart models.Cart
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("CartItems", func(db *gorm.DB) *gorm.DB {
+				// lock products in a stable order so concurrent reservations can't deadlock
+				return db.Order("product_id, variant_id")
+			}).
+			First(&cart, cartID).Error; err != nil {
+			return errors.New("cart not found")
+		}
+
+		if len(cart.CartItems) == 0 {
+			return errors.New("cart is empty")
+		}
+
+		// A new reservation replaces the cart's previous one
+		if err := tx.Where("cart_id = ?", cart.ID).Delete(&models.StockReservation{}).Error; err != nil {
+			return err
+		}
+
+		for i := range cart.CartItems {
+			cartItem := &cart.CartItems[i]
+
+			if err := lockProduct(tx, cartItem.ProductID); err != nil {
+				return err
+			}
+
+			// Read after taking the lock, so reservations committed meanwhile are counted
+			var product models.Product
+			if err := tx.Scopes(withReservedStock).Preload("Options", byPosition).First(&product, cartItem.ProductID).Error; err != nil {
+				return err
+			}
+
+			if !product.IsActive {
+				return fmt.Errorf("product is no longer available: %s", product.Name)
+			}
+
+			available := product.AvailableStock()
+			var variant *models.ProductVariant
+			if cartItem.VariantID != nil {
+				variant = &models.ProductVariant{}
+				if err := tx.Scopes(withVariantReservedStock).Preload("OptionValues").
+					Where("product_id = ?", product.ID).
+					First(variant, *cartItem.VariantID).Error; err != nil || !variant.IsActive {
+					return fmt.Errorf("product is no longer available: %s", product.Name)
+				}
+				available = variant.AvailableStock()
+			}
+
+			if available < cartItem.Quantity {
+				return fmt.Errorf("insufficient stock for product: %s", product.DisplayName(variant))
+			}
+
+			reservation := models.StockReservation{
+				CartID:    cart.ID,
+				ProductID: product.ID,
+				VariantID: cartItem.VariantID,
+				Quantity:  cartItem.Quantity,
+				ExpiresAt: expiresAt,
+			}
+			if err := tx.Create(&reservation).Error; err != nil {
+				return err
+			}
+
+			reservation.Product = product
+			reservation.Variant = variant
+			reservations = append(reservations, reservation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+// DeleteExpired implements StockReservationRepositoryInterface.
+func (r *StockReservationRepository) DeleteExpired(now time.Time) (int64, error) {
+	result := r.db.Where("expires_at <= ?", now).Delete(&models.StockReservation{})
+	return result.RowsAffected, result.Error
+}
+
+// GetCartReservedQuantity implements StockReservationRepositoryInterface.
+func (r *StockReservationRepository) GetCartReservedQuantity(cartID uint, line models.CartLine) (int, error) {
+	query := r.db.Model(&models.StockReservation{}).
+		Where("cart_id = ? AND product_id = ? AND expires_at > NOW()", cartID, line.ProductID)
+	if line.VariantID != 0 {
+		query = query.Where("variant_id = ?", line.VariantID)
+	} else {
+		query = query.Where("variant_id IS NULL")
+	}
+
+	var quantity int
+	if err := query.Select("COALESCE(SUM(quantity), 0)").Scan(&quantity).Error; err != nil {
+		return 0, err
+	}
+	return quantity, nil
+}
+
+// lockProduct locks the product row until tx ends. Stock and reservations
+// are only checked while holding it, so concurrent checkouts and reservations
+// of the same product take turns.
+func lockProduct(tx *gorm.DB, productID uint) error {
+	var product models.Product
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").First(&product, productID).Error
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+)
+
+var _ TaxRateRepositoryInterface = (*TaxRateRepository)(nil)
+
+type TaxRateRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxRateRepository(db *gorm.DB) *TaxRateRepository {
+	return &TaxRateRepository{db: db}
+}
+
+// GetTaxRates implements TaxRateRepositoryInterface.
+func (r *TaxRateRepository) GetTaxRates() ([]models.TaxRate, error) {
+	var rates []models.TaxRate
+	if err := r.db.Order("country, region, tax_class").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// GetByCountry implements TaxRateRepositoryInterface.
+func (r *TaxRateRepository) GetByCountry(country string) ([]models.TaxRate, error) {
+	var rates []models.TaxRate
+	if err := r.db.Where("country = ?", country).Find(&rates).Error; err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// Create implements TaxRateRepositoryInterface.
+func (r *TaxRateRepository) Create(rate *models.TaxRate) error {
+	return r.db.Create(rate).Error
+}
+
+// Delete implements TaxRateRepositoryInterface.
+func (r *TaxRateRepository) Delete(id uint) error {
+	return r.db.Delete(&models.TaxRate{}, id).Error
+}
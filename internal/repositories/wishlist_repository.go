@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ WishlistRepositoryInterface = (*WishlistRepository)(nil)
+
+type WishlistRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistRepository(db *gorm.DB) *WishlistRepository {
+	return &WishlistRepository{db: db}
+}
+
+// withWishlistItems loads the items of wishlists in the order they were added.
+func (r *WishlistRepository) withWishlistItems() *gorm.DB {
+	return r.db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("wishlist_items.created_at, wishlist_items.id")
+	}).Preload("Items.Product", withReservedStock).Scopes(withProductDetails("Items.Product"))
+}
+
+// GetByUserID implements WishlistRepositoryInterface.
+func (r *WishlistRepository) GetByUserID(userID uint) ([]models.Wishlist, error) {
+	var wishlists []models.Wishlist
+	if err := r.withWishlistItems().Where("user_id = ?", userID).Order("created_at, id").Find(&wishlists).Error; err != nil {
+		return nil, err
+	}
+	return wishlists, nil
+}
+
+// GetByUserIDAndID implements WishlistRepositoryInterface.
+func (r *WishlistRepository) GetByUserIDAndID(userID, wishlistID uint) (*models.Wishlist, error) {
+	var wishlist models.Wishlist
+	if err := r.withWishlistItems().Where("id = ? AND user_id = ?", wishlistID, userID).First(&wishlist).Error; err != nil {
+		return nil, err
+	}
+	return &wishlist, nil
+}
+
+// GetByShareToken implements WishlistRepositoryInterface.
+func (r *WishlistRepository) GetByShareToken(token string) (*models.Wishlist, error) {
+	var wishlist models.Wishlist
+	if err := r.withWishlistItems().Where("share_token = ?", token).First(&wishlist).Error; err != nil {
+		return nil, err
+	}
+	return &wishlist, nil
+}
+
+// Create implements WishlistRepositoryInterface.
+func (r *WishlistRepository) Create(wishlist *models.Wishlist) error {
+	return r.db.Create(wishlist).Error
+}
+
+// Update implements WishlistRepositoryInterface.
+func (r *WishlistRepository) Update(wishlist *models.Wishlist) error {
+	return r.db.Omit(clause.Associations).Save(wishlist).Error
+}
+
+// Delete implements WishlistRepositoryInterface.
+func (r *WishlistRepository) Delete(userID, wishlistID uint) error {
+	return r.db.Where("id = ? AND user_id = ?", wishlistID, userID).Delete(&models.Wishlist{}).Error
+}
+
+// AddItem implements WishlistRepositoryInterface.
+func (r *WishlistRepository) AddItem(item *models.WishlistItem) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(item).Error
+}
+
+// GetItem implements WishlistRepositoryInterface.
+func (r *WishlistRepository) GetItem(wishlistID, itemID uint) (*models.WishlistItem, error) {
+	var item models.WishlistItem
+	if err := r.db.Where("id = ? AND wishlist_id = ?", itemID, wishlistID).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// RemoveItem implements WishlistRepositoryInterface.
+func (r *WishlistRepository) RemoveItem(wishlistID, itemID uint) error {
+	return r.db.Where("id = ? AND wishlist_id = ?", itemID, wishlistID).Delete(&models.WishlistItem{}).Error
+}
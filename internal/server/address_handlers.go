@@ -0,0 +1,123 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Get user's addresses
+// @Description Retrieve the current user's saved addresses
+// @Tags Addresses
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=[]dto.AddressResponse} "Addresses retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /users/addresses [get]
+func (s *Server) getAddresses(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	addresses, err := s.addressService.GetAddresses(userID)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch addresses", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Addresses retrieved successfully", addresses)
+}
+
+// @Summary Create an address
+// @Description Save a new address to the current user's address book
+// @Tags Addresses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateAddressRequest true "Address data"
+// @Success 201 {object} utils.Response{data=dto.AddressResponse} "Address created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /users/addresses [post]
+func (s *Server) createAddress(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	var req dto.CreateAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	address, err := s.addressService.CreateAddress(userID, &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create address", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Address created successfully", address)
+}
+
+// @Summary Update an address
+// @Description Update one of the current user's saved addresses
+// @Tags Addresses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Address ID"
+// @Param request body dto.UpdateAddressRequest true "Address data"
+// @Success 200 {object} utils.Response{data=dto.AddressResponse} "Address updated successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /users/addresses/{id} [put]
+func (s *Server) updateAddress(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid address ID", err)
+		return
+	}
+
+	var req dto.UpdateAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	address, err := s.addressService.UpdateAddress(userID, uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to update address", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Address updated successfully", address)
+}
+
+// @Summary Delete an address
+// @Description Remove one of the current user's saved addresses
+// @Tags Addresses
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Address ID"
+// @Success 200 {object} utils.Response "Address deleted successfully"
+// @Failure 400 {object} utils.Response "Invalid address ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Address not found"
+// @Router /users/addresses/{id} [delete]
+func (s *Server) deleteAddress(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid address ID", err)
+		return
+	}
+
+	if err := s.addressService.DeleteAddress(userID, uint(id)); err != nil {
+		utils.NotFoundResponse(c, "Address not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Address deleted successfully", nil)
+}
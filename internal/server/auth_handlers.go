@@ -7,7 +7,7 @@ import (
 )
 
 // @Summary Register a new user
-// @Description Create a new user account with email and password
+// @Description Create a new user account with email and password. Items of the guest cart named by cart_token are merged into the new cart
 // @Tags Authentication
 // @Accept json
 // @Produce json
@@ -32,7 +32,7 @@ func (s *Server) register(c *gin.Context) {
 }
 
 // @Summary User login
-// @Description Authenticate user with email and password
+// @Description Authenticate user with email and password. Items of the guest cart named by cart_token are merged into the user's cart
 // @Tags Authentication
 // @Accept json
 // @Produce json
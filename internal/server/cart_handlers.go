@@ -120,3 +120,74 @@ func (s *Server) removeFromCart(c *gin.Context) {
 
 	utils.SuccessResponse(c, "Item removed from cart successfully", nil)
 }
+
+// @Summary Apply a coupon to the cart
+// @Description Apply a coupon code to the user's shopping cart. The coupon replaces any coupon already applied.
+// @Tags Cart
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.ApplyCouponRequest true "Coupon code"
+// @Success 200 {object} utils.Response{data=dto.CartResponse} "Coupon applied successfully"
+// @Failure 400 {object} utils.Response "Invalid, expired or inapplicable coupon"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /cart/coupon [post]
+func (s *Server) applyCoupon(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	var req dto.ApplyCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	cart, err := s.cartService.ApplyCoupon(userID, &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to apply coupon", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Coupon applied successfully", cart)
+}
+
+// @Summary Remove the coupon from the cart
+// @Description Remove the coupon applied to the user's shopping cart
+// @Tags Cart
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=dto.CartResponse} "Coupon removed successfully"
+// @Failure 400 {object} utils.Response "Cart not found"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /cart/coupon [delete]
+func (s *Server) removeCoupon(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	cart, err := s.cartService.RemoveCoupon(userID)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to remove coupon", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Coupon removed successfully", cart)
+}
+
+// @Summary Reserve stock for the cart
+// @Description Hold the stock for everything in the user's cart while they check out. The reservation expires after a while, and reserving again replaces it and starts it over.
+// @Tags Cart
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=dto.StockReservationResponse} "Stock reserved successfully"
+// @Failure 400 {object} utils.Response "Empty cart or insufficient stock"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /cart/reservation [post]
+func (s *Server) reserveStock(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	reservation, err := s.cartService.ReserveStock(userID)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to reserve stock", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Stock reserved successfully", reservation)
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Create a coupon
+// @Description Create a promotion coupon (Admin only)
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateCouponRequest true "Coupon data"
+// @Success 201 {object} utils.Response{data=dto.CouponResponse} "Coupon created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/coupons [post]
+func (s *Server) createCoupon(c *gin.Context) {
+	var req dto.CreateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	coupon, err := s.couponService.CreateCoupon(&req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create coupon", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Coupon created successfully", coupon)
+}
+
+// @Summary Get coupons
+// @Description Retrieve paginated list of coupons (Admin only)
+// @Tags Coupons
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.CouponResponse} "Coupons retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /admin/coupons [get]
+func (s *Server) getCoupons(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	coupons, meta, err := s.couponService.GetCoupons(page, limit)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch coupons", err)
+		return
+	}
+
+	utils.PaginatedSuccessResponse(c, "Coupons retrieved successfully", coupons, *meta)
+}
@@ -22,6 +22,10 @@ func (s *Server) createGraphQLHandler() *handler.Server {
 		s.userService,
 		s.productService, s.cartService,
 		s.orderService,
+		s.addressService,
+		s.shippingService,
+		s.returnService,
+		s.wishlistService,
 	)
 
 	schema := graph.NewExecutableSchema(graph.Config{Resolvers: rvr})
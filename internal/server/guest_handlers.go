@@ -0,0 +1,245 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Create a guest cart
+// @Description Start an empty cart for a shopper without an account. The returned cart token identifies the cart in the X-Cart-Token header of the other guest routes.
+// @Tags Guest
+// @Produce json
+// @Success 201 {object} utils.Response{data=dto.GuestCartResponse} "Guest cart created successfully"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /guest/cart [post]
+func (s *Server) createGuestCart(c *gin.Context) {
+	cart, err := s.cartService.CreateGuestCart()
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to create guest cart", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Guest cart created successfully", cart)
+}
+
+// @Summary Get guest cart
+// @Description Retrieve the guest cart with all items
+// @Tags Guest
+// @Produce json
+// @Security CartToken
+// @Success 200 {object} utils.Response{data=dto.CartResponse} "Cart retrieved successfully"
+// @Failure 401 {object} utils.Response "Missing or invalid cart token"
+// @Failure 404 {object} utils.Response "Cart not found"
+// @Router /guest/cart [get]
+func (s *Server) getGuestCart(c *gin.Context) {
+	cartID := c.GetUint("cart_id")
+
+	cart, err := s.cartService.GetGuestCart(cartID)
+	if err != nil {
+		utils.NotFoundResponse(c, "Cart not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Cart retrieved successfully", cart)
+}
+
+// @Summary Add item to guest cart
+// @Description Add a product to the guest cart
+// @Tags Guest
+// @Accept json
+// @Produce json
+// @Security CartToken
+// @Param request body dto.AddToCartRequest true "Item to add to cart"
+// @Success 200 {object} utils.Response{data=dto.CartResponse} "Item added to cart successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or insufficient stock"
+// @Failure 401 {object} utils.Response "Missing or invalid cart token"
+// @Router /guest/cart/items [post]
+func (s *Server) addToGuestCart(c *gin.Context) {
+	cartID := c.GetUint("cart_id")
+
+	var req dto.AddToCartRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	cart, err := s.cartService.AddToGuestCart(cartID, &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to add item to cart", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Item added to cart successfully", cart)
+}
+
+// @Summary Update guest cart item quantity
+// @Description Update the quantity of an item in the guest cart
+// @Tags Guest
+// @Accept json
+// @Produce json
+// @Security CartToken
+// @Param id path int true "Cart Item ID"
+// @Param request body dto.UpdateCartItemRequest true "New quantity"
+// @Success 200 {object} utils.Response{data=dto.CartResponse} "Cart item updated successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or insufficient stock"
+// @Failure 401 {object} utils.Response "Missing or invalid cart token"
+// @Router /guest/cart/items/{id} [put]
+func (s *Server) updateGuestCartItem(c *gin.Context) {
+	cartID := c.GetUint("cart_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid cart item ID", err)
+		return
+	}
+
+	var req dto.UpdateCartItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	cart, err := s.cartService.UpdateGuestCartItem(cartID, uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to update cart item", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Cart item updated successfully", cart)
+}
+
+// @Summary Remove item from guest cart
+// @Description Remove an item from the guest cart
+// @Tags Guest
+// @Security CartToken
+// @Param id path int true "Cart Item ID"
+// @Success 200 {object} utils.Response "Item removed from cart successfully"
+// @Failure 400 {object} utils.Response "Invalid cart item ID or cart not found"
+// @Failure 401 {object} utils.Response "Missing or invalid cart token"
+// @Router /guest/cart/items/{id} [delete]
+func (s *Server) removeFromGuestCart(c *gin.Context) {
+	cartID := c.GetUint("cart_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid cart item ID", err)
+		return
+	}
+
+	if err := s.cartService.RemoveFromGuestCart(cartID, uint(id)); err != nil {
+		utils.BadRequestResponse(c, "Failed to remove item from cart", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Item removed from cart successfully", nil)
+}
+
+// @Summary Get shipping options for the guest cart
+// @Description Quote the shipping methods available for the guest cart to a country, cheapest first
+// @Tags Guest
+// @Produce json
+// @Security CartToken
+// @Param country query string true "ISO 3166-1 alpha-2 country code to ship to"
+// @Param region query string false "Region to ship to"
+// @Success 200 {object} utils.Response{data=[]dto.ShippingOptionResponse} "Shipping options retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid country, empty cart or no shipping available"
+// @Failure 401 {object} utils.Response "Missing or invalid cart token"
+// @Router /guest/cart/shipping-options [get]
+func (s *Server) getGuestShippingOptions(c *gin.Context) {
+	cartID := c.GetUint("cart_id")
+
+	var req dto.GuestShippingOptionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	options, err := s.shippingService.GetGuestShippingOptions(cartID, &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to get shipping options", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Shipping options retrieved successfully", options)
+}
+
+// @Summary Reserve stock for the guest cart
+// @Description Hold the stock for everything in the guest cart during checkout. The reservation expires after a while, and reserving again replaces it and starts it over.
+// @Tags Guest
+// @Produce json
+// @Security CartToken
+// @Success 200 {object} utils.Response{data=dto.StockReservationResponse} "Stock reserved successfully"
+// @Failure 400 {object} utils.Response "Empty cart or insufficient stock"
+// @Failure 401 {object} utils.Response "Missing or invalid cart token"
+// @Router /guest/cart/reservation [post]
+func (s *Server) reserveGuestStock(c *gin.Context) {
+	cartID := c.GetUint("cart_id")
+
+	reservation, err := s.cartService.ReserveGuestStock(cartID)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to reserve stock", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Stock reserved successfully", reservation)
+}
+
+// @Summary Check out as a guest
+// @Description Create an order from the guest cart for an email and address. The response carries the order access token that is needed to look the order up again. Signed-in shoppers can claim the order for their account with its email and access token.
+// @Tags Guest
+// @Accept json
+// @Produce json
+// @Security CartToken
+// @Param request body dto.GuestCheckoutRequest true "Contact email, addresses and payment token"
+// @Param Idempotency-Key header string false "Unique key that makes retries of this request replay the original response"
+// @Success 201 {object} utils.Response{data=dto.GuestOrderResponse} "Order created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data, empty cart, insufficient stock or payment declined"
+// @Failure 401 {object} utils.Response "Missing or invalid cart token"
+// @Failure 409 {object} utils.Response "Idempotency key reused with a different request or still in progress"
+// @Router /guest/checkout [post]
+func (s *Server) guestCheckout(c *gin.Context) {
+	cartID := c.GetUint("cart_id")
+
+	var req dto.GuestCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	order, err := s.orderService.CreateGuestOrder(cartID, &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create order", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Order created successfully", order)
+}
+
+// @Summary Look up a guest order
+// @Description Retrieve a guest order by the email it was placed with and its order access token
+// @Tags Guest
+// @Accept json
+// @Produce json
+// @Param request body dto.GuestOrderLookupRequest true "Email and order access token"
+// @Success 200 {object} utils.Response{data=dto.OrderResponse} "Order retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 404 {object} utils.Response "Order not found"
+// @Router /guest/orders/lookup [post]
+func (s *Server) lookupGuestOrder(c *gin.Context) {
+	var req dto.GuestOrderLookupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	order, err := s.orderService.GetGuestOrder(&req)
+	if err != nil {
+		utils.NotFoundResponse(c, "Order not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Order retrieved successfully", order)
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/services"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Download order invoice
+// @Description Download the PDF invoice of one of the current user's orders. Invoices are issued when an order is confirmed
+// @Tags Orders
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Success 200 {file} file "Invoice PDF"
+// @Failure 400 {object} utils.Response "Invalid order ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Order not found"
+// @Failure 409 {object} utils.Response "Invoice not issued yet"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /orders/{id}/invoice.pdf [get]
+func (s *Server) getOrderInvoice(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	file, err := s.invoiceService.GetInvoice(userID, uint(id))
+	s.sendInvoice(c, file, err)
+}
+
+// @Summary Download any order invoice
+// @Description Download the PDF invoice of any user's order (Admin only)
+// @Tags Orders
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Success 200 {file} file "Invoice PDF"
+// @Failure 400 {object} utils.Response "Invalid order ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 404 {object} utils.Response "Order not found"
+// @Failure 409 {object} utils.Response "Invoice not issued yet"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /admin/orders/{id}/invoice.pdf [get]
+func (s *Server) getAnyOrderInvoice(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	file, err := s.invoiceService.GetAnyInvoice(uint(id))
+	s.sendInvoice(c, file, err)
+}
+
+func (s *Server) sendInvoice(c *gin.Context, file *dto.InvoiceFile, err error) {
+	switch {
+	case errors.Is(err, services.ErrOrderNotFound):
+		utils.NotFoundResponse(c, "Order not found")
+	case errors.Is(err, services.ErrInvoiceNotIssued):
+		utils.ConflictResponse(c, "Invoice not issued yet", err)
+	case err != nil:
+		utils.InternalServerErrorResponse(c, "Failed to fetch invoice", err)
+	default:
+		c.Header("Content-Disposition", `attachment; filename="`+file.FileName+`"`)
+		c.Data(http.StatusOK, file.ContentType, file.Content)
+	}
+}
@@ -1,13 +1,28 @@
 package server
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/services"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
 )
 
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 255
+	cartTokenHeader         = "X-Cart-Token"
+)
+
 func (s *Server) authMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		//Authorization: Bearer JWT
@@ -40,6 +55,29 @@ func (s *Server) authMiddleware() gin.HandlerFunc {
 	}
 }
 
+// guestCartMiddleware identifies the guest cart from the X-Cart-Token header.
+func (s *Server) guestCartMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(cartTokenHeader)
+		if token == "" {
+			utils.UnauthorizedResponse(c, "Cart token required")
+			c.Abort()
+			return
+		}
+
+		cartID, err := utils.ValidateCartToken(token, s.config.JWT.Secret)
+		if err != nil {
+			utils.UnauthorizedResponse(c, "Invalid cart token")
+			c.Abort()
+			return
+		}
+
+		c.Set("cart_id", cartID)
+
+		c.Next()
+	}
+}
+
 func (s *Server) adminMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		role, exists := c.Get("user_role")
@@ -58,3 +96,134 @@ func (s *Server) adminMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// idempotencyMiddleware makes authenticated POST and PUT requests safe to retry.
+// Keys belong to the user, or for guests to their cart, so it must run after
+// authMiddleware or guestCartMiddleware.
+// The first request carrying an Idempotency-Key runs normally and its response is
+// stored; a retry with the same key and body replays that response, while the same
+// key with a different request is rejected with 409. Only successful responses are
+// stored: non-2xx responses, and GraphQL responses that carry errors despite their
+// 200, release the key so the client can try again.
+func (s *Server) idempotencyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(idempotencyKeyHeader)
+		if key == "" || (c.Request.Method != http.MethodPost && c.Request.Method != http.MethodPut) {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			utils.BadRequestResponse(c, "Invalid idempotency key", errors.New("idempotency key is too long"))
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			utils.BadRequestResponse(c, "Invalid request body", err)
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		owner := idempotencyOwner(c)
+		stored, err := s.idempotencyService.Start(owner, key, requestFingerprint(c.Request, body))
+		if err != nil {
+			if errors.Is(err, services.ErrIdempotencyKeyReused) || errors.Is(err, services.ErrIdempotencyKeyInProgress) {
+				utils.ConflictResponse(c, "Idempotency key conflict", err)
+			} else {
+				utils.InternalServerErrorResponse(c, "Failed to process idempotency key", err)
+			}
+			c.Abort()
+			return
+		}
+
+		if stored != nil {
+			c.Header("Idempotent-Replayed", "true")
+			c.Data(stored.StatusCode, stored.ContentType, stored.Body)
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		completed := false
+		defer func() {
+			// runs on panics too, so the key isn't stuck in progress
+			if completed {
+				return
+			}
+			if err := s.idempotencyService.Release(owner, key); err != nil {
+				s.logger.Error().Err(err).Str("key", key).Msg("failed to release idempotency key")
+			}
+		}()
+
+		c.Next()
+
+		if recorder.Status() < http.StatusOK || recorder.Status() >= http.StatusMultipleChoices ||
+			hasGraphQLErrors(recorder.body.Bytes()) {
+			return
+		}
+
+		if err := s.idempotencyService.Complete(owner, key, &dto.IdempotentResponse{
+			StatusCode:  recorder.Status(),
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}); err != nil {
+			s.logger.Error().Err(err).Str("key", key).Msg("failed to store idempotent response")
+			return
+		}
+		completed = true
+	}
+}
+
+// idempotencyOwner returns the user of the request, or the guest cart when
+// there is no user.
+func idempotencyOwner(c *gin.Context) models.IdempotencyOwner {
+	if _, exists := c.Get("user_id"); exists {
+		userID := c.GetUint("user_id")
+		return models.IdempotencyOwner{UserID: &userID}
+	}
+
+	cartID := c.GetUint("cart_id")
+	return models.IdempotencyOwner{CartID: &cartID}
+}
+
+// requestFingerprint identifies a request by method, path, query and body.
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// hasGraphQLErrors reports whether body is a GraphQL response with a non-empty
+// errors array. GraphQL answers failed operations with 200, so the status alone
+// doesn't tell.
+func hasGraphQLErrors(body []byte) bool {
+	var response struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return false
+	}
+	return len(response.Errors) > 0
+}
+
+// responseRecorder keeps a copy of everything written to the response.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
@@ -1,25 +1,38 @@
 package server
 
 import (
+	"errors"
+	"io"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	_ "github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
 )
 
 // @Summary Create an order
-// @Description Create an order from the current user's cart
+// @Description Create an order from the current user's cart. Addresses default to the user's saved defaults when omitted.
 // @Tags Orders
+// @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param request body dto.CreateOrderRequest false "Shipping and billing address selection and payment token"
+// @Param Idempotency-Key header string false "Unique key that makes retries of this request replay the original response"
 // @Success 201 {object} utils.Response{data=dto.OrderResponse} "Order created successfully"
-// @Failure 400 {object} utils.Response "Cart is empty or insufficient stock"
+// @Failure 400 {object} utils.Response "Cart is empty, insufficient stock, no shipping address or payment declined"
 // @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 409 {object} utils.Response "Idempotency key reused with a different request or still in progress"
 // @Router /orders [post]
 func (s *Server) createOrder(c *gin.Context) {
 	userID := c.GetUint("user_id")
-	order, err := s.orderService.CreateOrder(userID)
+
+	var req dto.CreateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	order, err := s.orderService.CreateOrder(userID, &req)
 	if err != nil {
 		utils.BadRequestResponse(c, "Failed to create order", err)
 		return
@@ -82,3 +95,260 @@ func (s *Server) getOrder(c *gin.Context) {
 
 	utils.SuccessResponse(c, "Order retrieved successfully", order)
 }
+
+// @Summary Claim a guest order
+// @Description Move an order placed as a guest to the current user's account. It takes the email the order was placed with and its order access token
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.GuestOrderLookupRequest true "Email and order access token"
+// @Success 200 {object} utils.Response{data=dto.OrderResponse} "Order claimed successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Order not found"
+// @Router /orders/claim [post]
+func (s *Server) claimGuestOrder(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	var req dto.GuestOrderLookupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	order, err := s.orderService.ClaimGuestOrder(userID, &req)
+	if err != nil {
+		utils.NotFoundResponse(c, "Order not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Order claimed successfully", order)
+}
+
+// @Summary Cancel an order
+// @Description Cancel one of the current user's orders while it is pending or confirmed. The stock of every item is restored
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Param request body dto.CancelOrderRequest true "Cancellation reason"
+// @Success 200 {object} utils.Response{data=dto.OrderResponse} "Order cancelled successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or order can no longer be cancelled"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /orders/{id}/cancel [post]
+func (s *Server) cancelOrder(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	var req dto.CancelOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	order, err := s.orderService.CancelOrder(userID, uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to cancel order", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Order cancelled successfully", order)
+}
+
+// @Summary Reorder a past order
+// @Description Copy the products of one of the current user's orders into their cart. Products no longer sold are skipped and quantities are reduced to the stock left; those lines are listed in adjustments
+// @Tags Orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Success 200 {object} utils.Response{data=dto.ReorderResponse} "Order added to cart successfully"
+// @Failure 400 {object} utils.Response "Invalid order ID or order not found"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /orders/{id}/reorder [post]
+func (s *Server) reorder(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	result, err := s.cartService.Reorder(userID, uint(id))
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to reorder", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Order added to cart successfully", result)
+}
+
+// @Summary Get all orders
+// @Description Retrieve a paginated list of every user's orders with customer details (Admin only)
+// @Tags Orders
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param status query string false "Filter by status" Enums(pending, confirmed, shipped, delivered, cancelled)
+// @Param created_from query string false "Only orders created at or after this RFC 3339 time"
+// @Param created_to query string false "Only orders created before this RFC 3339 time"
+// @Param user_email query string false "Filter by customer email"
+// @Param min_total query number false "Minimum order total"
+// @Param sort_by query string false "Sort field" Enums(created_at, total) default(created_at)
+// @Param sort_order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.OrderResponse} "Orders retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid filter"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/orders [get]
+func (s *Server) getAllOrders(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	var filter dto.AdminOrderFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.BadRequestResponse(c, "Invalid filter", err)
+		return
+	}
+
+	orders, meta, err := s.orderService.GetAllOrders(&filter, page, limit)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to fetch orders", err)
+		return
+	}
+
+	utils.PaginatedSuccessResponse(c, "Orders retrieved successfully", orders, *meta)
+}
+
+// @Summary Get any order by ID
+// @Description Retrieve detailed information about any user's order, including the customer (Admin only)
+// @Tags Orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Success 200 {object} utils.Response{data=dto.OrderResponse} "Order retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid order ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 404 {object} utils.Response "Order not found"
+// @Router /admin/orders/{id} [get]
+func (s *Server) getAnyOrder(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	order, err := s.orderService.GetOrderByID(uint(id))
+	if err != nil {
+		utils.NotFoundResponse(c, "Order not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Order retrieved successfully", order)
+}
+
+// @Summary Update order status
+// @Description Move an order to a new status (Admin only). Allowed transitions are pending→confirmed and pending/confirmed→cancelled. Orders become shipped and delivered through their shipments
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Param request body dto.UpdateOrderStatusRequest true "New order status"
+// @Success 200 {object} utils.Response{data=dto.OrderResponse} "Order status updated successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or status transition"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/orders/{id}/status [put]
+func (s *Server) updateOrderStatus(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	var req dto.UpdateOrderStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	order, err := s.orderService.UpdateOrderStatus(uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to update order status", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Order status updated successfully", order)
+}
+
+// @Summary Create a shipment
+// @Description Ship items of a confirmed order in one parcel with its carrier and tracking number. Without items everything not yet shipped is included. The payment is captured and, once every item has shipped, the order moves to shipped (Admin only)
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Param request body dto.CreateShipmentRequest true "Carrier, tracking number and items to ship"
+// @Success 201 {object} utils.Response{data=dto.OrderResponse} "Shipment created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data, order not confirmed or quantity not shippable"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/orders/{id}/shipments [post]
+func (s *Server) createShipment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	var req dto.CreateShipmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	order, err := s.orderService.CreateShipment(uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create shipment", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Shipment created successfully", order)
+}
+
+// @Summary Mark a shipment delivered
+// @Description Record that a parcel arrived. Once the order has fully shipped and every shipment is delivered, the order moves to delivered (Admin only)
+// @Tags Orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Shipment ID"
+// @Success 200 {object} utils.Response{data=dto.OrderResponse} "Shipment delivered successfully"
+// @Failure 400 {object} utils.Response "Invalid shipment ID or shipment already delivered"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/shipments/{id}/deliver [post]
+func (s *Server) markShipmentDelivered(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid shipment ID", err)
+		return
+	}
+
+	order, err := s.orderService.MarkShipmentDelivered(uint(id))
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to mark shipment delivered", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Shipment delivered successfully", order)
+}
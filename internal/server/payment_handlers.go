@@ -0,0 +1,39 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/interfaces"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Receive a payment webhook
+// @Description Apply an asynchronous payment result from the payment provider. Pending orders are confirmed or cancelled accordingly.
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "Hex HMAC-SHA256 of the raw body using the webhook secret"
+// @Success 200 {object} utils.Response "Webhook processed successfully"
+// @Failure 400 {object} utils.Response "Invalid webhook payload"
+// @Failure 401 {object} utils.Response "Invalid webhook signature"
+// @Router /payments/webhook [post]
+func (s *Server) paymentWebhook(c *gin.Context) {
+	payload, err := c.GetRawData()
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid webhook payload", err)
+		return
+	}
+
+	if err := s.orderService.HandlePaymentWebhook(payload, c.GetHeader("X-Payment-Signature")); err != nil {
+		if errors.Is(err, interfaces.ErrInvalidWebhookSignature) {
+			utils.ErrorResponse(c, http.StatusUnauthorized, "Invalid webhook signature", err)
+			return
+		}
+		utils.BadRequestResponse(c, "Failed to process webhook", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Webhook processed successfully", nil)
+}
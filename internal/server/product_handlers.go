@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/services"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
 )
 
@@ -17,9 +18,10 @@ import (
 // @Security BearerAuth
 // @Param request body dto.CreateCategoryRequest true "Category data"
 // @Success 201 {object} utils.Response{data=dto.CategoryResponse} "Category created successfully"
-// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 400 {object} utils.Response "Invalid request data, parent category not found or slug already in use"
 // @Failure 401 {object} utils.Response "Unauthorized"
 // @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 500 {object} utils.Response "Internal server error"
 // @Router /categories [post]
 func (s *Server) createCategory(c *gin.Context) {
 	var req dto.CreateCategoryRequest
@@ -30,7 +32,7 @@ func (s *Server) createCategory(c *gin.Context) {
 
 	category, err := s.productService.CreateCategory(&req)
 	if err != nil {
-		utils.InternalServerErrorResponse(c, "Failed to create category", err)
+		categoryErrorResponse(c, "Failed to create category", err)
 		return
 	}
 
@@ -56,8 +58,25 @@ func (s *Server) getCategories(c *gin.Context) {
 	utils.SuccessResponse(c, "Categories retrieved successfully", categories)
 }
 
+// @Summary Get the category tree
+// @Description Retrieve the active categories nested under their parent categories
+// @Tags Categories
+// @Produce json
+// @Success 200 {object} utils.Response{data=[]dto.CategoryTreeResponse} "Category tree retrieved successfully"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /categories/tree [get]
+func (s *Server) getCategoryTree(c *gin.Context) {
+	tree, err := s.productService.GetCategoryTree()
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch category tree", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Category tree retrieved successfully", tree)
+}
+
 // @Summary Update a category
-// @Description Update an existing category (Admin only)
+// @Description Update an existing category, moving it under another parent category (Admin only)
 // @Tags Categories
 // @Accept json
 // @Produce json
@@ -65,9 +84,11 @@ func (s *Server) getCategories(c *gin.Context) {
 // @Param id path int true "Category ID"
 // @Param request body dto.UpdateCategoryRequest true "Category update data"
 // @Success 200 {object} utils.Response{data=dto.CategoryResponse} "Category updated successfully"
-// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 400 {object} utils.Response "Invalid request data, parent category not found, moved under itself or slug already in use"
 // @Failure 401 {object} utils.Response "Unauthorized"
 // @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 404 {object} utils.Response "Category not found"
+// @Failure 500 {object} utils.Response "Internal server error"
 // @Router /categories/{id} [put]
 func (s *Server) updateCategory(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
@@ -84,7 +105,7 @@ func (s *Server) updateCategory(c *gin.Context) {
 
 	category, err := s.productService.UpdateCategory(uint(id), &req)
 	if err != nil {
-		utils.InternalServerErrorResponse(c, "Failed to update category", err)
+		categoryErrorResponse(c, "Failed to update category", err)
 		return
 	}
 
@@ -93,14 +114,15 @@ func (s *Server) updateCategory(c *gin.Context) {
 }
 
 // @Summary Delete a category
-// @Description Delete a category (Admin only)
+// @Description Delete a category without subcategories (Admin only)
 // @Tags Categories
 // @Security BearerAuth
 // @Param id path int true "Category ID"
 // @Success 200 {object} utils.Response "Category deleted successfully"
-// @Failure 400 {object} utils.Response "Invalid category ID"
+// @Failure 400 {object} utils.Response "Invalid category ID or category has subcategories"
 // @Failure 401 {object} utils.Response "Unauthorized"
 // @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 500 {object} utils.Response "Internal server error"
 // @Router /categories/{id} [delete]
 func (s *Server) deleteCategory(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
@@ -110,13 +132,30 @@ func (s *Server) deleteCategory(c *gin.Context) {
 	}
 
 	if err := s.productService.DeleteCategory(uint(id)); err != nil {
-		utils.InternalServerErrorResponse(c, "Failed to delete category", err)
+		categoryErrorResponse(c, "Failed to delete category", err)
 		return
 	}
 
 	utils.SuccessResponse(c, "Category deleted successfully", nil)
 }
 
+// categoryErrorResponse answers a failed category change: 404 for a missing
+// category, 400 when the request can't be carried out, and 500 otherwise.
+func categoryErrorResponse(c *gin.Context, message string, err error) {
+	switch {
+	case errors.Is(err, services.ErrCategoryNotFound):
+		utils.NotFoundResponse(c, "Category not found")
+	case errors.Is(err, services.ErrParentCategoryNotFound),
+		errors.Is(err, services.ErrCategoryCycle),
+		errors.Is(err, services.ErrInvalidCategorySlug),
+		errors.Is(err, services.ErrCategorySlugTaken),
+		errors.Is(err, services.ErrCategoryHasChildren):
+		utils.BadRequestResponse(c, message, err)
+	default:
+		utils.InternalServerErrorResponse(c, message, err)
+	}
+}
+
 // @Summary Create a new product
 // @Description Create a new product (Admin only)
 // @Tags Products
@@ -146,19 +185,29 @@ func (s *Server) createProduct(c *gin.Context) {
 }
 
 // @Summary Get all products
-// @Description Retrieve paginated list of active products
+// @Description Retrieve paginated list of active products, optionally in a category and the categories under it
 // @Tags Products
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
+// @Param category_id query int false "Filter by category ID"
+// @Param include_subcategories query bool false "Also include products in the categories under category_id"
+// @Param sort query string false "Sort order" Enums(newest, price_asc, price_desc, popularity) default(newest)
 // @Success 200 {object} utils.PaginatedResponse{data=[]dto.ProductResponse} "Products retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid filter"
 // @Failure 500 {object} utils.Response "Internal server error"
 // @Router /products [get]
 func (s *Server) getProducts(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
-	products, meta, err := s.productService.GetProducts(page, limit)
+	var filter dto.ProductFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.BadRequestResponse(c, "Invalid filter", err)
+		return
+	}
+
+	products, meta, err := s.productService.GetProducts(&filter, page, limit)
 	if err != nil {
 		utils.InternalServerErrorResponse(c, "Failed to fetch products", err)
 		return
@@ -253,13 +302,14 @@ func (s *Server) deleteProduct(c *gin.Context) {
 }
 
 // @Summary Upload product image
-// @Description Upload an image for a product (Admin only)
+// @Description Upload an image for a product, or for one of its variants (Admin only)
 // @Tags Products
 // @Accept multipart/form-data
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Product ID"
 // @Param image formData file true "Image file"
+// @Param variant_id formData int false "Variant the image shows"
 // @Success 200 {object} utils.Response{data=map[string]string} "Image uploaded successfully"
 // @Failure 400 {object} utils.Response "Invalid request or file"
 // @Failure 401 {object} utils.Response "Unauthorized"
@@ -278,13 +328,24 @@ func (s *Server) uploadProductImage(c *gin.Context) {
 		return
 	}
 
+	var variantID *uint
+	if value := c.PostForm("variant_id"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			utils.BadRequestResponse(c, "Invalid variant ID", err)
+			return
+		}
+		variant := uint(parsed)
+		variantID = &variant
+	}
+
 	url, err := s.uploadService.UploadProductImage(uint(id), file)
 	if err != nil {
 		utils.InternalServerErrorResponse(c, "Failed to upload image", err)
 		return
 	}
 
-	if err := s.productService.AddProductImage(uint(id), url, file.Filename); err != nil {
+	if err := s.productService.AddProductImage(uint(id), variantID, url, file.Filename); err != nil {
 		utils.InternalServerErrorResponse(c, "Failed to save image record", err)
 		return
 	}
@@ -293,16 +354,20 @@ func (s *Server) uploadProductImage(c *gin.Context) {
 }
 
 // @Summary Search products
-// @Description Search products using full-text search with ranking
+// @Description Search products using full-text search with ranking. Query words are also matched by their search synonyms. The response includes facet counts by category, price range, stock and variant attribute, each applying every filter but its own
 // @Tags Products
 // @Produce json
 // @Param q query string true "Search query"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
 // @Param category_id query int false "Filter by category ID"
+// @Param include_subcategories query bool false "Also match products in the categories under category_id"
 // @Param min_price query number false "Minimum price filter"
 // @Param max_price query number false "Maximum price filter"
-// @Success 200 {object} utils.PaginatedResponse{data=[]dto.ProductSearchResult} "Search results"
+// @Param in_stock query bool false "Only products in stock, or only those out of stock"
+// @Param attribute query []string false "Variant attribute filter as option:value, e.g. Size:M" collectionFormat(multi)
+// @Param sort query string false "Sort order" Enums(relevance, newest, price_asc, price_desc, popularity) default(relevance)
+// @Success 200 {object} utils.SearchResponse{data=[]dto.ProductSearchResult,facets=dto.SearchFacetsResponse} "Search results"
 // @Failure 400 {object} utils.Response "Invalid search query"
 // @Failure 500 {object} utils.Response "Internal server error"
 // @Router /search [get]
@@ -313,12 +378,39 @@ func (s *Server) searchProducts(c *gin.Context) {
 		return
 	}
 
-	results, meta, err := s.productService.SearchProducts(&req)
+	results, facets, meta, err := s.productService.SearchProducts(&req)
 	if err != nil {
 		s.logger.Error().Err(err).Msg("Product search failed")
 		utils.InternalServerErrorResponse(c, "Search failed", errors.New("unable to complete search at this time"))
 		return
 	}
 
-	utils.PaginatedSuccessResponse(c, "OK", results, *meta)
+	utils.SearchSuccessResponse(c, "OK", results, *meta, facets)
+}
+
+// @Summary Search suggestions
+// @Description Suggest products and categories as a search query is typed. Names that start with the query, or have a word that does, come first, followed by names resembling it so misspellings still find matches
+// @Tags Products
+// @Produce json
+// @Param q query string true "Partly typed search query"
+// @Param limit query int false "Number of products and of categories to suggest" default(5)
+// @Success 200 {object} utils.Response{data=dto.SearchSuggestionsResponse} "Search suggestions"
+// @Failure 400 {object} utils.Response "Invalid search query"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /search/suggest [get]
+func (s *Server) getSearchSuggestions(c *gin.Context) {
+	var req dto.SearchSuggestRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid search parameters", err)
+		return
+	}
+
+	suggestions, err := s.productService.SuggestSearch(&req)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Search suggestions failed")
+		utils.InternalServerErrorResponse(c, "Search suggestions failed", errors.New("unable to suggest searches at this time"))
+		return
+	}
+
+	utils.SuccessResponse(c, "OK", suggestions)
 }
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Add a product option
+// @Description Add an option such as size or colour, with its values, to a product (Admin only)
+// @Tags Products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param request body dto.CreateProductOptionRequest true "Option name and values"
+// @Success 201 {object} utils.Response{data=dto.ProductResponse} "Product option created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /products/{id}/options [post]
+func (s *Server) createProductOption(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid product ID", err)
+		return
+	}
+
+	var req dto.CreateProductOptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	product, err := s.productService.CreateProductOption(uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create product option", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Product option created successfully", product)
+}
+
+// @Summary Add a product variant
+// @Description Add a variant with its own SKU, price and stock for one value of each of the product's options (Admin only)
+// @Tags Products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param request body dto.CreateProductVariantRequest true "Variant data"
+// @Success 201 {object} utils.Response{data=dto.ProductVariantResponse} "Product variant created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /products/{id}/variants [post]
+func (s *Server) createProductVariant(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid product ID", err)
+		return
+	}
+
+	var req dto.CreateProductVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	variant, err := s.productService.CreateProductVariant(uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create product variant", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Product variant created successfully", variant)
+}
+
+// @Summary Update a product variant
+// @Description Update a variant's price, stock and whether it is sold (Admin only)
+// @Tags Products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param variant_id path int true "Variant ID"
+// @Param request body dto.UpdateProductVariantRequest true "Variant update data"
+// @Success 200 {object} utils.Response{data=dto.ProductVariantResponse} "Product variant updated successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /products/{id}/variants/{variant_id} [put]
+func (s *Server) updateProductVariant(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid product ID", err)
+		return
+	}
+
+	variantID, err := strconv.ParseUint(c.Param("variant_id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid variant ID", err)
+		return
+	}
+
+	var req dto.UpdateProductVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	variant, err := s.productService.UpdateProductVariant(uint(id), uint(variantID), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to update product variant", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Product variant updated successfully", variant)
+}
+
+// @Summary Delete a product variant
+// @Description Delete a variant of a product and take it out of carts (Admin only)
+// @Tags Products
+// @Security BearerAuth
+// @Param id path int true "Product ID"
+// @Param variant_id path int true "Variant ID"
+// @Success 200 {object} utils.Response "Product variant deleted successfully"
+// @Failure 400 {object} utils.Response "Invalid ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 404 {object} utils.Response "Variant not found"
+// @Router /products/{id}/variants/{variant_id} [delete]
+func (s *Server) deleteProductVariant(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid product ID", err)
+		return
+	}
+
+	variantID, err := strconv.ParseUint(c.Param("variant_id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid variant ID", err)
+		return
+	}
+
+	if err := s.productService.DeleteProductVariant(uint(id), uint(variantID)); err != nil {
+		utils.NotFoundResponse(c, "Variant not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Product variant deleted successfully", nil)
+}
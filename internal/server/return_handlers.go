@@ -0,0 +1,252 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Request a return
+// @Description Open a return for items of one of the current user's delivered orders
+// @Tags Returns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Order ID"
+// @Param request body dto.CreateReturnRequest true "Items to return and the reason"
+// @Success 201 {object} utils.Response{data=dto.ReturnResponse} "Return requested successfully"
+// @Failure 400 {object} utils.Response "Invalid request data, order not delivered or quantity not returnable"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /orders/{id}/returns [post]
+func (s *Server) requestReturn(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid order ID", err)
+		return
+	}
+
+	var req dto.CreateReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	returnRequest, err := s.returnService.RequestReturn(userID, uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to request return", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Return requested successfully", returnRequest)
+}
+
+// @Summary Get user's returns
+// @Description Retrieve paginated list of the current user's returns
+// @Tags Returns
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.ReturnResponse} "Returns retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /returns [get]
+func (s *Server) getReturns(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	returns, meta, err := s.returnService.GetReturns(userID, page, limit)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch returns", err)
+		return
+	}
+
+	utils.PaginatedSuccessResponse(c, "Returns retrieved successfully", returns, *meta)
+}
+
+// @Summary Get return by ID
+// @Description Retrieve one of the current user's returns
+// @Tags Returns
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Return ID"
+// @Success 200 {object} utils.Response{data=dto.ReturnResponse} "Return retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid return ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Return not found"
+// @Router /returns/{id} [get]
+func (s *Server) getReturn(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid return ID", err)
+		return
+	}
+
+	returnRequest, err := s.returnService.GetReturn(userID, uint(id))
+	if err != nil {
+		utils.NotFoundResponse(c, "Return not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Return retrieved successfully", returnRequest)
+}
+
+// @Summary Get all returns
+// @Description Retrieve a paginated list of every user's returns (Admin only)
+// @Tags Returns
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Param status query string false "Filter by status" Enums(requested, approved, received, refunded, rejected)
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.ReturnResponse} "Returns retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid status"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/returns [get]
+func (s *Server) getAllReturns(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	returns, meta, err := s.returnService.GetAllReturns(c.Query("status"), page, limit)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to fetch returns", err)
+		return
+	}
+
+	utils.PaginatedSuccessResponse(c, "Returns retrieved successfully", returns, *meta)
+}
+
+// @Summary Approve a return
+// @Description Approve a requested return so the customer can send the items back (Admin only)
+// @Tags Returns
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Return ID"
+// @Success 200 {object} utils.Response{data=dto.ReturnResponse} "Return approved successfully"
+// @Failure 400 {object} utils.Response "Invalid return ID or status transition"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/returns/{id}/approve [post]
+func (s *Server) approveReturn(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid return ID", err)
+		return
+	}
+
+	returnRequest, err := s.returnService.ApproveReturn(uint(id))
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to approve return", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Return approved successfully", returnRequest)
+}
+
+// @Summary Reject a return
+// @Description Reject a requested or approved return (Admin only)
+// @Tags Returns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Return ID"
+// @Param request body dto.RejectReturnRequest true "Rejection reason"
+// @Success 200 {object} utils.Response{data=dto.ReturnResponse} "Return rejected successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or status transition"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/returns/{id}/reject [post]
+func (s *Server) rejectReturn(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid return ID", err)
+		return
+	}
+
+	var req dto.RejectReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	returnRequest, err := s.returnService.RejectReturn(uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to reject return", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Return rejected successfully", returnRequest)
+}
+
+// @Summary Mark a return received
+// @Description Record that the items of an approved return arrived. The items are put back in stock (Admin only)
+// @Tags Returns
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Return ID"
+// @Success 200 {object} utils.Response{data=dto.ReturnResponse} "Return received successfully"
+// @Failure 400 {object} utils.Response "Invalid return ID or status transition"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/returns/{id}/receive [post]
+func (s *Server) receiveReturn(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid return ID", err)
+		return
+	}
+
+	returnRequest, err := s.returnService.ReceiveReturn(uint(id))
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to receive return", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Return received successfully", returnRequest)
+}
+
+// @Summary Refund a return
+// @Description Refund a received return through the payment provider and record the refund against the order. Without an amount, what was paid for the returned items is refunded (Admin only)
+// @Tags Returns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Return ID"
+// @Param request body dto.RefundReturnRequest false "Refund amount"
+// @Success 200 {object} utils.Response{data=dto.ReturnResponse} "Return refunded successfully"
+// @Failure 400 {object} utils.Response "Invalid amount, status transition or refund declined"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/returns/{id}/refund [post]
+func (s *Server) refundReturn(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid return ID", err)
+		return
+	}
+
+	var req dto.RefundReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	returnRequest, err := s.returnService.RefundReturn(uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to refund return", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Return refunded successfully", returnRequest)
+}
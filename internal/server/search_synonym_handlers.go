@@ -0,0 +1,83 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Get search synonyms
+// @Description Retrieve the synonyms product searches are expanded with (Admin only)
+// @Tags Search
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=[]dto.SearchSynonymResponse} "Search synonyms retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /admin/search-synonyms [get]
+func (s *Server) getSearchSynonyms(c *gin.Context) {
+	synonyms, err := s.productService.GetSearchSynonyms()
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch search synonyms", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Search synonyms retrieved successfully", synonyms)
+}
+
+// @Summary Create a search synonym
+// @Description Make product searches for a word also match a synonym of it, e.g. "tv" and "television". Synonyms work one way (Admin only)
+// @Tags Search
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateSearchSynonymRequest true "Search synonym data"
+// @Success 201 {object} utils.Response{data=dto.SearchSynonymResponse} "Search synonym created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data, a term that isn't a single word, or duplicate synonym"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/search-synonyms [post]
+func (s *Server) createSearchSynonym(c *gin.Context) {
+	var req dto.CreateSearchSynonymRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	synonym, err := s.productService.CreateSearchSynonym(&req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create search synonym", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Search synonym created successfully", synonym)
+}
+
+// @Summary Delete a search synonym
+// @Description Delete a search synonym (Admin only)
+// @Tags Search
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Search synonym ID"
+// @Success 200 {object} utils.Response "Search synonym deleted successfully"
+// @Failure 400 {object} utils.Response "Invalid search synonym ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/search-synonyms/{id} [delete]
+func (s *Server) deleteSearchSynonym(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid search synonym ID", err)
+		return
+	}
+
+	if err := s.productService.DeleteSearchSynonym(uint(id)); err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to delete search synonym", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Search synonym deleted successfully", nil)
+}
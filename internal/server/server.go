@@ -14,14 +14,22 @@ import (
 )
 
 type Server struct {
-	config         *config.Config
-	logger         zerolog.Logger
-	authService    services.AuthServiceInterface
-	productService services.ProductServiceInterface
-	userService    services.UserServiceInterface
-	uploadService  services.UploadServiceInterface
-	cartService    services.CartServiceInterface
-	orderService   services.OrderServiceInterface
+	config             *config.Config
+	logger             zerolog.Logger
+	authService        services.AuthServiceInterface
+	productService     services.ProductServiceInterface
+	userService        services.UserServiceInterface
+	uploadService      services.UploadServiceInterface
+	cartService        services.CartServiceInterface
+	orderService       services.OrderServiceInterface
+	idempotencyService services.IdempotencyServiceInterface
+	addressService     services.AddressServiceInterface
+	couponService      services.CouponServiceInterface
+	taxService         services.TaxServiceInterface
+	shippingService    services.ShippingServiceInterface
+	returnService      services.ReturnServiceInterface
+	invoiceService     services.InvoiceServiceInterface
+	wishlistService    services.WishlistServiceInterface
 }
 
 func New(cfg *config.Config,
@@ -32,16 +40,32 @@ func New(cfg *config.Config,
 	uploadService services.UploadServiceInterface,
 	cartService services.CartServiceInterface,
 	orderServuce services.OrderServiceInterface,
+	idempotencyService services.IdempotencyServiceInterface,
+	addressService services.AddressServiceInterface,
+	couponService services.CouponServiceInterface,
+	taxService services.TaxServiceInterface,
+	shippingService services.ShippingServiceInterface,
+	returnService services.ReturnServiceInterface,
+	invoiceService services.InvoiceServiceInterface,
+	wishlistService services.WishlistServiceInterface,
 ) *Server {
 	return &Server{
-		config:         cfg,
-		logger:         logger,
-		authService:    authService,
-		productService: productService,
-		userService:    userService,
-		uploadService:  uploadService,
-		cartService:    cartService,
-		orderService:   orderServuce,
+		config:             cfg,
+		logger:             logger,
+		authService:        authService,
+		productService:     productService,
+		userService:        userService,
+		uploadService:      uploadService,
+		cartService:        cartService,
+		orderService:       orderServuce,
+		idempotencyService: idempotencyService,
+		addressService:     addressService,
+		couponService:      couponService,
+		taxService:         taxService,
+		shippingService:    shippingService,
+		returnService:      returnService,
+		invoiceService:     invoiceService,
+		wishlistService:    wishlistService,
 	}
 }
 
@@ -78,6 +102,7 @@ func (s *Server) SetupRoutes() *gin.Engine {
 
 	graphqlProtected := router.Group("/graphql")
 	graphqlProtected.Use(s.authMiddleware())
+	graphqlProtected.Use(s.idempotencyMiddleware())
 	graphqlProtected.Use(s.graphqlMiddleware())
 	graphqlProtected.POST("/", s.graphqlHandler())
 
@@ -93,11 +118,16 @@ func (s *Server) SetupRoutes() *gin.Engine {
 		}
 		protected := api.Group("/")
 		protected.Use(s.authMiddleware())
+		protected.Use(s.idempotencyMiddleware())
 		{
 			users := protected.Group("/users")
 			{
 				users.GET("/profile", s.getProfile)
 				users.PUT("/profile", s.updateProfile)
+				users.GET("/addresses", s.getAddresses)
+				users.POST("/addresses", s.createAddress)
+				users.PUT("/addresses/:id", s.updateAddress)
+				users.DELETE("/addresses/:id", s.deleteAddress)
 			}
 
 			// category routes
@@ -117,6 +147,10 @@ func (s *Server) SetupRoutes() *gin.Engine {
 				productRoutes.PUT("/:id", s.adminMiddleware(), s.updateProduct)
 				productRoutes.DELETE("/:id", s.adminMiddleware(), s.deleteProduct)
 				productRoutes.POST("/:id/images", s.adminMiddleware(), s.uploadProductImage)
+				productRoutes.POST("/:id/options", s.adminMiddleware(), s.createProductOption)
+				productRoutes.POST("/:id/variants", s.adminMiddleware(), s.createProductVariant)
+				productRoutes.PUT("/:id/variants/:variant_id", s.adminMiddleware(), s.updateProductVariant)
+				productRoutes.DELETE("/:id/variants/:variant_id", s.adminMiddleware(), s.deleteProductVariant)
 			}
 
 			// cart routes
@@ -127,6 +161,26 @@ func (s *Server) SetupRoutes() *gin.Engine {
 				cartRoutes.POST("/items", s.addToCart)
 				cartRoutes.PUT("/items/:id", s.updateCartItem)
 				cartRoutes.DELETE("/items/:id", s.removeFromCart)
+				cartRoutes.POST("/coupon", s.applyCoupon)
+				cartRoutes.DELETE("/coupon", s.removeCoupon)
+				cartRoutes.GET("/shipping-options", s.getShippingOptions)
+				cartRoutes.POST("/reservation", s.reserveStock)
+			}
+
+			// wishlist routes
+			wishlists := protected.Group("/wishlists")
+			{
+				wishlistRoutes := wishlists
+				wishlistRoutes.GET("/", s.getWishlists)
+				wishlistRoutes.POST("/", s.createWishlist)
+				wishlistRoutes.GET("/:id", s.getWishlist)
+				wishlistRoutes.PUT("/:id", s.updateWishlist)
+				wishlistRoutes.DELETE("/:id", s.deleteWishlist)
+				wishlistRoutes.POST("/:id/items", s.addWishlistItem)
+				wishlistRoutes.DELETE("/:id/items/:item_id", s.removeWishlistItem)
+				wishlistRoutes.POST("/:id/items/:item_id/move-to-cart", s.moveWishlistItemToCart)
+				wishlistRoutes.POST("/:id/share", s.shareWishlist)
+				wishlistRoutes.DELETE("/:id/share", s.unshareWishlist)
 			}
 
 			// Order routes
@@ -135,15 +189,80 @@ func (s *Server) SetupRoutes() *gin.Engine {
 				orderRoutes := orders
 				orderRoutes.POST("/", s.createOrder)
 				orderRoutes.GET("/", s.getOrders)
+				orderRoutes.POST("/claim", s.claimGuestOrder)
 				orderRoutes.GET("/:id", s.getOrder)
+				orderRoutes.GET("/:id/invoice.pdf", s.getOrderInvoice)
+				orderRoutes.POST("/:id/cancel", s.cancelOrder)
+				orderRoutes.POST("/:id/reorder", s.reorder)
+				orderRoutes.POST("/:id/returns", s.requestReturn)
+			}
+
+			// Return routes
+			returns := protected.Group("/returns")
+			{
+				returnRoutes := returns
+				returnRoutes.GET("/", s.getReturns)
+				returnRoutes.GET("/:id", s.getReturn)
+			}
+
+			// admin routes
+			admin := protected.Group("/admin")
+			admin.Use(s.adminMiddleware())
+			{
+				adminRoutes := admin
+				adminRoutes.GET("/orders", s.getAllOrders)
+				adminRoutes.GET("/orders/:id", s.getAnyOrder)
+				adminRoutes.GET("/orders/:id/invoice.pdf", s.getAnyOrderInvoice)
+				adminRoutes.PUT("/orders/:id/status", s.updateOrderStatus)
+				adminRoutes.POST("/orders/:id/shipments", s.createShipment)
+				adminRoutes.POST("/shipments/:id/deliver", s.markShipmentDelivered)
+				adminRoutes.GET("/coupons", s.getCoupons)
+				adminRoutes.POST("/coupons", s.createCoupon)
+				adminRoutes.GET("/tax-rates", s.getTaxRates)
+				adminRoutes.POST("/tax-rates", s.createTaxRate)
+				adminRoutes.DELETE("/tax-rates/:id", s.deleteTaxRate)
+				adminRoutes.GET("/search-synonyms", s.getSearchSynonyms)
+				adminRoutes.POST("/search-synonyms", s.createSearchSynonym)
+				adminRoutes.DELETE("/search-synonyms/:id", s.deleteSearchSynonym)
+				adminRoutes.GET("/returns", s.getAllReturns)
+				adminRoutes.POST("/returns/:id/approve", s.approveReturn)
+				adminRoutes.POST("/returns/:id/reject", s.rejectReturn)
+				adminRoutes.POST("/returns/:id/receive", s.receiveReturn)
+				adminRoutes.POST("/returns/:id/refund", s.refundReturn)
+				adminRoutes.GET("/shipping-zones", s.getShippingZones)
+				adminRoutes.POST("/shipping-zones", s.createShippingZone)
+				adminRoutes.POST("/shipping-zones/:id/methods", s.createShippingMethod)
 			}
 		}
 
 		// public routes
 		api.GET("/categories", s.getCategories)
+		api.GET("/categories/tree", s.getCategoryTree)
 		api.GET("/products", s.getProducts)
 		api.GET("/products/:id", s.getProduct)
 		api.GET("/search", s.searchProducts)
+		api.GET("/search/suggest", s.getSearchSuggestions)
+		api.GET("/wishlists/shared/:token", s.getSharedWishlist)
+		api.POST("/payments/webhook", s.paymentWebhook)
+
+		// guest routes, for shoppers without an account
+		guest := api.Group("/guest")
+		{
+			guest.POST("/cart", s.createGuestCart)
+			guest.POST("/orders/lookup", s.lookupGuestOrder)
+
+			guestCart := guest.Group("/")
+			guestCart.Use(s.guestCartMiddleware())
+			{
+				guestCart.GET("/cart", s.getGuestCart)
+				guestCart.POST("/cart/items", s.addToGuestCart)
+				guestCart.PUT("/cart/items/:id", s.updateGuestCartItem)
+				guestCart.DELETE("/cart/items/:id", s.removeFromGuestCart)
+				guestCart.GET("/cart/shipping-options", s.getGuestShippingOptions)
+				guestCart.POST("/cart/reservation", s.reserveGuestStock)
+				guestCart.POST("/checkout", s.idempotencyMiddleware(), s.guestCheckout)
+			}
+		}
 
 	}
 
@@ -158,7 +277,7 @@ func (s *Server) corsMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Header("Access-Control-Allow-Origin", "*")
 		ctx.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		ctx.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		ctx.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key, X-Cart-Token")
 
 		if ctx.Request.Method == "OPTIONS" {
 			ctx.AbortWithStatus(204)
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Get shipping options for the cart
+// @Description Quote the shipping methods available for the user's cart to an address, cheapest first. Without an address_id or country the default shipping address is used.
+// @Tags Cart
+// @Produce json
+// @Security BearerAuth
+// @Param address_id query int false "Address book entry to ship to"
+// @Param country query string false "ISO 3166-1 alpha-2 country code to ship to"
+// @Param region query string false "Region to ship to"
+// @Success 200 {object} utils.Response{data=[]dto.ShippingOptionResponse} "Shipping options retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid address, empty cart or no shipping available"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /cart/shipping-options [get]
+func (s *Server) getShippingOptions(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	var req dto.ShippingOptionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	options, err := s.shippingService.GetShippingOptions(userID, &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to get shipping options", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Shipping options retrieved successfully", options)
+}
+
+// @Summary Get shipping zones
+// @Description Retrieve all shipping zones with their locations and methods (Admin only)
+// @Tags Shipping
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=[]dto.ShippingZoneResponse} "Shipping zones retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /admin/shipping-zones [get]
+func (s *Server) getShippingZones(c *gin.Context) {
+	zones, err := s.shippingService.GetZones()
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch shipping zones", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Shipping zones retrieved successfully", zones)
+}
+
+// @Summary Create a shipping zone
+// @Description Create a shipping zone covering countries or regions (Admin only)
+// @Tags Shipping
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateShippingZoneRequest true "Shipping zone data"
+// @Success 201 {object} utils.Response{data=dto.ShippingZoneResponse} "Shipping zone created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/shipping-zones [post]
+func (s *Server) createShippingZone(c *gin.Context) {
+	var req dto.CreateShippingZoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	zone, err := s.shippingService.CreateZone(&req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create shipping zone", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Shipping zone created successfully", zone)
+}
+
+// @Summary Create a shipping method
+// @Description Add a flat rate, weight based or free over threshold shipping method to a zone (Admin only)
+// @Tags Shipping
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Shipping zone ID"
+// @Param request body dto.CreateShippingMethodRequest true "Shipping method data"
+// @Success 201 {object} utils.Response{data=dto.ShippingMethodResponse} "Shipping method created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or zone not found"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/shipping-zones/{id}/methods [post]
+func (s *Server) createShippingMethod(c *gin.Context) {
+	zoneID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid shipping zone ID", err)
+		return
+	}
+
+	var req dto.CreateShippingMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	method, err := s.shippingService.CreateMethod(uint(zoneID), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create shipping method", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Shipping method created successfully", method)
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Get tax rates
+// @Description Retrieve all configured tax rates (Admin only)
+// @Tags Tax
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=[]dto.TaxRateResponse} "Tax rates retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /admin/tax-rates [get]
+func (s *Server) getTaxRates(c *gin.Context) {
+	rates, err := s.taxService.GetTaxRates()
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch tax rates", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Tax rates retrieved successfully", rates)
+}
+
+// @Summary Create a tax rate
+// @Description Set the tax rate for a tax class in a country or region (Admin only)
+// @Tags Tax
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateTaxRateRequest true "Tax rate data"
+// @Success 201 {object} utils.Response{data=dto.TaxRateResponse} "Tax rate created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or duplicate rate"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/tax-rates [post]
+func (s *Server) createTaxRate(c *gin.Context) {
+	var req dto.CreateTaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	rate, err := s.taxService.CreateTaxRate(&req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create tax rate", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Tax rate created successfully", rate)
+}
+
+// @Summary Delete a tax rate
+// @Description Delete a tax rate (Admin only)
+// @Tags Tax
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Tax rate ID"
+// @Success 200 {object} utils.Response "Tax rate deleted successfully"
+// @Failure 400 {object} utils.Response "Invalid tax rate ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Admin access required"
+// @Router /admin/tax-rates/{id} [delete]
+func (s *Server) deleteTaxRate(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid tax rate ID", err)
+		return
+	}
+
+	if err := s.taxService.DeleteTaxRate(uint(id)); err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to delete tax rate", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Tax rate deleted successfully", nil)
+}
@@ -0,0 +1,343 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+// @Summary Get user's wishlists
+// @Description Retrieve the current user's wishlists with their items
+// @Tags Wishlists
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=[]dto.WishlistResponse} "Wishlists retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 500 {object} utils.Response "Internal server error"
+// @Router /wishlists [get]
+func (s *Server) getWishlists(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	wishlists, err := s.wishlistService.GetWishlists(userID)
+	if err != nil {
+		utils.InternalServerErrorResponse(c, "Failed to fetch wishlists", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Wishlists retrieved successfully", wishlists)
+}
+
+// @Summary Create a wishlist
+// @Description Create a new named wishlist for the current user
+// @Tags Wishlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateWishlistRequest true "Wishlist name"
+// @Success 201 {object} utils.Response{data=dto.WishlistResponse} "Wishlist created successfully"
+// @Failure 400 {object} utils.Response "Invalid request data"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /wishlists [post]
+func (s *Server) createWishlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	var req dto.CreateWishlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	wishlist, err := s.wishlistService.CreateWishlist(userID, &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to create wishlist", err)
+		return
+	}
+
+	utils.CreatedResponse(c, "Wishlist created successfully", wishlist)
+}
+
+// @Summary Get wishlist by ID
+// @Description Retrieve one of the current user's wishlists with its items
+// @Tags Wishlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Success 200 {object} utils.Response{data=dto.WishlistResponse} "Wishlist retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid wishlist ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Wishlist not found"
+// @Router /wishlists/{id} [get]
+func (s *Server) getWishlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	wishlist, err := s.wishlistService.GetWishlist(userID, uint(id))
+	if err != nil {
+		utils.NotFoundResponse(c, "Wishlist not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Wishlist retrieved successfully", wishlist)
+}
+
+// @Summary Get a shared wishlist
+// @Description Retrieve a wishlist its owner shared, using the token from the share link. No login is needed
+// @Tags Wishlists
+// @Produce json
+// @Param token path string true "Share token"
+// @Success 200 {object} utils.Response{data=dto.WishlistResponse} "Wishlist retrieved successfully"
+// @Failure 404 {object} utils.Response "Wishlist not found"
+// @Router /wishlists/shared/{token} [get]
+func (s *Server) getSharedWishlist(c *gin.Context) {
+	wishlist, err := s.wishlistService.GetSharedWishlist(c.Param("token"))
+	if err != nil {
+		utils.NotFoundResponse(c, "Wishlist not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Wishlist retrieved successfully", wishlist)
+}
+
+// @Summary Rename a wishlist
+// @Description Change the name of one of the current user's wishlists
+// @Tags Wishlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Param request body dto.UpdateWishlistRequest true "Wishlist name"
+// @Success 200 {object} utils.Response{data=dto.WishlistResponse} "Wishlist updated successfully"
+// @Failure 400 {object} utils.Response "Invalid request data or wishlist not found"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /wishlists/{id} [put]
+func (s *Server) updateWishlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	var req dto.UpdateWishlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	wishlist, err := s.wishlistService.UpdateWishlist(userID, uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to update wishlist", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Wishlist updated successfully", wishlist)
+}
+
+// @Summary Delete a wishlist
+// @Description Delete one of the current user's wishlists and everything on it
+// @Tags Wishlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Success 200 {object} utils.Response "Wishlist deleted successfully"
+// @Failure 400 {object} utils.Response "Invalid wishlist ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Wishlist not found"
+// @Router /wishlists/{id} [delete]
+func (s *Server) deleteWishlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	if err := s.wishlistService.DeleteWishlist(userID, uint(id)); err != nil {
+		utils.NotFoundResponse(c, "Wishlist not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Wishlist deleted successfully", nil)
+}
+
+// @Summary Add a product to a wishlist
+// @Description Save a product to one of the current user's wishlists. Adding a product that is already on the list leaves it unchanged
+// @Tags Wishlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Param request body dto.AddWishlistItemRequest true "Product to add"
+// @Success 200 {object} utils.Response{data=dto.WishlistResponse} "Item added to wishlist successfully"
+// @Failure 400 {object} utils.Response "Invalid request data, wishlist or product not found"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /wishlists/{id}/items [post]
+func (s *Server) addWishlistItem(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	var req dto.AddWishlistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	wishlist, err := s.wishlistService.AddItem(userID, uint(id), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to add item to wishlist", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Item added to wishlist successfully", wishlist)
+}
+
+// @Summary Remove an item from a wishlist
+// @Description Take a product off one of the current user's wishlists
+// @Tags Wishlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Param item_id path int true "Wishlist item ID"
+// @Success 200 {object} utils.Response{data=dto.WishlistResponse} "Item removed from wishlist successfully"
+// @Failure 400 {object} utils.Response "Invalid wishlist or item ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Wishlist item not found"
+// @Router /wishlists/{id}/items/{item_id} [delete]
+func (s *Server) removeWishlistItem(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	itemID, err := strconv.ParseUint(c.Param("item_id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist item ID", err)
+		return
+	}
+
+	wishlist, err := s.wishlistService.RemoveItem(userID, uint(id), uint(itemID))
+	if err != nil {
+		utils.NotFoundResponse(c, "Wishlist item not found")
+		return
+	}
+
+	utils.SuccessResponse(c, "Item removed from wishlist successfully", wishlist)
+}
+
+// @Summary Move a wishlist item to the cart
+// @Description Add a wishlist item's product to the cart, with the cart's stock checks, and take it off the wishlist. Without a quantity one is added
+// @Tags Wishlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Param item_id path int true "Wishlist item ID"
+// @Param request body dto.MoveWishlistItemRequest false "Quantity to add to the cart"
+// @Success 200 {object} utils.Response{data=dto.CartResponse} "Item moved to cart successfully"
+// @Failure 400 {object} utils.Response "Invalid request data, item not found or insufficient stock"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /wishlists/{id}/items/{item_id}/move-to-cart [post]
+func (s *Server) moveWishlistItemToCart(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	itemID, err := strconv.ParseUint(c.Param("item_id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist item ID", err)
+		return
+	}
+
+	var req dto.MoveWishlistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		utils.BadRequestResponse(c, "Invalid request data", err)
+		return
+	}
+
+	cart, err := s.wishlistService.MoveToCart(userID, uint(id), uint(itemID), &req)
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to move item to cart", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Item moved to cart successfully", cart)
+}
+
+// @Summary Share a wishlist
+// @Description Turn on sharing for one of the current user's wishlists. Anyone with the returned share token can view the list at /wishlists/shared/{token}. A list that is already shared keeps its token
+// @Tags Wishlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Success 200 {object} utils.Response{data=dto.WishlistResponse} "Wishlist shared successfully"
+// @Failure 400 {object} utils.Response "Invalid wishlist ID or wishlist not found"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /wishlists/{id}/share [post]
+func (s *Server) shareWishlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	wishlist, err := s.wishlistService.ShareWishlist(userID, uint(id))
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to share wishlist", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Wishlist shared successfully", wishlist)
+}
+
+// @Summary Stop sharing a wishlist
+// @Description Turn off sharing for one of the current user's wishlists, so its share link stops working
+// @Tags Wishlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Wishlist ID"
+// @Success 200 {object} utils.Response{data=dto.WishlistResponse} "Wishlist unshared successfully"
+// @Failure 400 {object} utils.Response "Invalid wishlist ID or wishlist not found"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /wishlists/{id}/share [delete]
+func (s *Server) unshareWishlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		utils.BadRequestResponse(c, "Invalid wishlist ID", err)
+		return
+	}
+
+	wishlist, err := s.wishlistService.UnshareWishlist(userID, uint(id))
+	if err != nil {
+		utils.BadRequestResponse(c, "Failed to unshare wishlist", err)
+		return
+	}
+
+	utils.SuccessResponse(c, "Wishlist unshared successfully", wishlist)
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+var _ AddressServiceInterface = (*AddressService)(nil)
+
+type AddressService struct {
+	addressRepo repositories.AddressRepositoryInterface
+}
+
+func NewAddressService(addressRepo repositories.AddressRepositoryInterface) *AddressService {
+	return &AddressService{addressRepo: addressRepo}
+}
+
+func (s *AddressService) GetAddresses(userID uint) ([]dto.AddressResponse, error) {
+	addresses, err := s.addressRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.AddressResponse, len(addresses))
+	for i := range addresses {
+		response[i] = s.convertToAddressResponse(&addresses[i])
+	}
+
+	return response, nil
+}
+
+func (s *AddressService) GetAddress(userID, addressID uint) (*dto.AddressResponse, error) {
+	address, err := s.addressRepo.GetByUserIDAndID(userID, addressID)
+	if err != nil {
+		return nil, errors.New("address not found")
+	}
+
+	response := s.convertToAddressResponse(address)
+	return &response, nil
+}
+
+func (s *AddressService) CreateAddress(userID uint, req *dto.CreateAddressRequest) (*dto.AddressResponse, error) {
+	address := models.Address{
+		UserID:            userID,
+		Label:             req.Label,
+		FirstName:         req.FirstName,
+		LastName:          req.LastName,
+		Phone:             req.Phone,
+		Line1:             req.Line1,
+		Line2:             req.Line2,
+		City:              req.City,
+		Region:            req.Region,
+		PostalCode:        req.PostalCode,
+		Country:           strings.ToUpper(req.Country),
+		IsDefaultShipping: req.IsDefaultShipping,
+		IsDefaultBilling:  req.IsDefaultBilling,
+	}
+
+	// The first address becomes the default for both until the user picks others
+	if existing, err := s.addressRepo.GetByUserID(userID); err == nil && len(existing) == 0 {
+		address.IsDefaultShipping = true
+		address.IsDefaultBilling = true
+	}
+
+	if err := s.addressRepo.Create(&address); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToAddressResponse(&address)
+	return &response, nil
+}
+
+func (s *AddressService) UpdateAddress(userID, addressID uint, req *dto.UpdateAddressRequest) (*dto.AddressResponse, error) {
+	address, err := s.addressRepo.GetByUserIDAndID(userID, addressID)
+	if err != nil {
+		return nil, errors.New("address not found")
+	}
+
+	address.Label = req.Label
+	address.FirstName = req.FirstName
+	address.LastName = req.LastName
+	address.Phone = req.Phone
+	address.Line1 = req.Line1
+	address.Line2 = req.Line2
+	address.City = req.City
+	address.Region = req.Region
+	address.PostalCode = req.PostalCode
+	address.Country = strings.ToUpper(req.Country)
+	if req.IsDefaultShipping != nil {
+		address.IsDefaultShipping = *req.IsDefaultShipping
+	}
+	if req.IsDefaultBilling != nil {
+		address.IsDefaultBilling = *req.IsDefaultBilling
+	}
+
+	if err := s.addressRepo.Update(address); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToAddressResponse(address)
+	return &response, nil
+}
+
+func (s *AddressService) DeleteAddress(userID, addressID uint) error {
+	if _, err := s.addressRepo.GetByUserIDAndID(userID, addressID); err != nil {
+		return errors.New("address not found")
+	}
+
+	return s.addressRepo.Delete(userID, addressID)
+}
+
+func (s *AddressService) convertToAddressResponse(address *models.Address) dto.AddressResponse {
+	return dto.AddressResponse{
+		ID:                address.ID,
+		Label:             address.Label,
+		FirstName:         address.FirstName,
+		LastName:          address.LastName,
+		Phone:             address.Phone,
+		Line1:             address.Line1,
+		Line2:             address.Line2,
+		City:              address.City,
+		Region:            address.Region,
+		PostalCode:        address.PostalCode,
+		Country:           address.Country,
+		IsDefaultShipping: address.IsDefaultShipping,
+		IsDefaultBilling:  address.IsDefaultBilling,
+		CreatedAt:         address.CreatedAt,
+		UpdatedAt:         address.UpdatedAt,
+	}
+}
@@ -34,6 +34,8 @@ func NewAuthService(userRepo repositories.UserRepositoryInterface,
 	}
 }
 
+// Register creates a customer account. Guest orders aren't moved to it
+// here, since the email isn't verified; each is claimed with its access token.
 func (s *AuthService) Register(req *dto.RegisterRequest) (*dto.AuthResponse, error) {
 	// Check if user exists
 
@@ -60,13 +62,19 @@ func (s *AuthService) Register(req *dto.RegisterRequest) (*dto.AuthResponse, err
 		return nil, err
 	}
 	// create a cart
-	cart := models.Cart{UserID: user.ID}
+	cart := models.Cart{UserID: &user.ID}
 	if err := s.cartRepo.Create(&cart); err != nil {
 		fmt.Println("Unable to create cart")
 	}
 
 	// generate token
-	return s.generateAuthResponse(&user)
+	response, err := s.generateAuthResponse(&user)
+	if err != nil {
+		return nil, err
+	}
+
+	response.CartAdjustments = s.mergeGuestCart(user.ID, req.CartToken)
+	return response, nil
 
 }
 
@@ -80,7 +88,62 @@ func (s *AuthService) Login(req *dto.LoginRequest) (*dto.AuthResponse, error) {
 		return nil, errors.New("invalid credentials")
 	}
 
-	return s.generateAuthResponse(user)
+	response, err := s.generateAuthResponse(user)
+	if err != nil {
+		return nil, err
+	}
+
+	response.CartAdjustments = s.mergeGuestCart(user.ID, req.CartToken)
+	return response, nil
+}
+
+// mergeGuestCart merges the guest cart identified by cartToken into the
+// user's cart in one transaction and reports the items that couldn't be
+// added in full. The user is already signed in at this point, so a cart that
+// can't be merged is logged rather than failing the sign in.
+func (s *AuthService) mergeGuestCart(userID uint, cartToken string) []dto.CartMergeAdjustmentResponse {
+	adjustments := []dto.CartMergeAdjustmentResponse{}
+	if cartToken == "" {
+		return adjustments
+	}
+
+	cartID, err := utils.ValidateCartToken(cartToken, s.config.JWT.Secret)
+	if err != nil {
+		log.Printf("ignoring invalid cart token for user %d: %v", userID, err)
+		return adjustments
+	}
+
+	lines, err := s.cartRepo.MergeGuestCart(cartID, userID)
+	if err != nil {
+		log.Printf("unable to merge guest cart %d into cart of user %d: %v", cartID, userID, err)
+		return adjustments
+	}
+
+	for _, line := range lines {
+		adjustment := dto.CartMergeAdjustmentResponse{
+			ProductID:         line.ProductID,
+			ProductName:       line.ProductName,
+			RequestedQuantity: line.RequestedQuantity,
+			AddedQuantity:     line.AddedQuantity,
+		}
+
+		switch {
+		case line.Unavailable:
+			adjustment.Status = dto.CartMergeStatusSkipped
+			adjustment.Reason = "product is no longer available"
+		case line.AddedQuantity == 0:
+			adjustment.Status = dto.CartMergeStatusSkipped
+			adjustment.Reason = "out of stock"
+		case line.AddedQuantity < line.RequestedQuantity:
+			adjustment.Status = dto.CartMergeStatusAdjusted
+			adjustment.Reason = fmt.Sprintf("only %d more in stock", line.AddedQuantity)
+		default:
+			continue
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+
+	return adjustments
 }
 
 func (s *AuthService) RefreshToken(req *dto.RefreshTokenRequest) (*dto.AuthResponse, error) {
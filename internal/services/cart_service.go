@@ -2,25 +2,48 @@ package services
 
 import (
 	"errors"
-	"log"
+	"fmt"
+	"time"
 
+	"github.com/vijayaragavanmg/learning-go-shop/internal/config"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/tax"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
 )
 
 var _ CartServiceInterface = (*CartService)(nil)
 
 type CartService struct {
-	cartRepo    repositories.CartRepositoryInterface
-	productRepo repositories.ProductRepositoryInterface
+	cartRepo        repositories.CartRepositoryInterface
+	productRepo     repositories.ProductRepositoryInterface
+	couponRepo      repositories.CouponRepositoryInterface
+	addressRepo     repositories.AddressRepositoryInterface
+	orderRepo       repositories.OrderRepositoryInterface
+	reservationRepo repositories.StockReservationRepositoryInterface
+	taxService      TaxServiceInterface
+	config          *config.Config
 }
 
 func NewCartService(cartRepo repositories.CartRepositoryInterface,
-	productRepo repositories.ProductRepositoryInterface) *CartService {
+	productRepo repositories.ProductRepositoryInterface,
+	couponRepo repositories.CouponRepositoryInterface,
+	addressRepo repositories.AddressRepositoryInterface,
+	orderRepo repositories.OrderRepositoryInterface,
+	reservationRepo repositories.StockReservationRepositoryInterface,
+	taxService TaxServiceInterface,
+	config *config.Config) *CartService {
 	return &CartService{
-		cartRepo:    cartRepo,
-		productRepo: productRepo,
+		cartRepo:        cartRepo,
+		productRepo:     productRepo,
+		couponRepo:      couponRepo,
+		addressRepo:     addressRepo,
+		orderRepo:       orderRepo,
+		reservationRepo: reservationRepo,
+		taxService:      taxService,
+		config:          config,
 	}
 }
 
@@ -31,61 +54,172 @@ func (s *CartService) GetCart(userID uint) (*dto.CartResponse, error) {
 		return nil, err
 	}
 
-	return s.convertToCartResponse(cart), nil
+	// Tax is estimated for the default shipping address; checkout uses the address chosen there
+	var taxAddress tax.Address
+	if address, err := s.addressRepo.GetDefaultShipping(userID); err == nil {
+		taxAddress = tax.Address{Country: address.Country, Region: address.Region}
+	}
+
+	calculator, err := s.taxService.CalculatorFor(taxAddress.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToCartResponse(cart, taxAddress, calculator), nil
 }
 
 func (s *CartService) AddToCart(userID uint, req *dto.AddToCartRequest) (*dto.CartResponse, error) {
+	cart, err := s.getOrCreateCart(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.addToCart(cart.ID, req); err != nil {
+		return nil, err
+	}
 
+	return s.GetCart(userID)
+}
+
+// addToCart adds the requested product to the cart, checking that there is
+// stock for everything of it in the cart afterwards.
+func (s *CartService) addToCart(cartID uint, req *dto.AddToCartRequest) error {
 	// Check if product exists
 	product, err := s.productRepo.GetProductByID(req.ProductID)
 	if err != nil {
-		return nil, errors.New("product not found")
+		return errors.New("product not found")
 	}
 
-	if product.Stock < req.Quantity {
-		return nil, errors.New("insufficient stock")
+	variant, err := sellableVariant(product, req.VariantID)
+	if err != nil {
+		return err
 	}
 
-	// Get or create cart
-	// var cart models.Cart
-	cart, err := s.cartRepo.GetByUserID(userID)
-	if err != nil {
-		cart := models.Cart{UserID: userID}
+	quantity := req.Quantity
+	if cartItem, err := s.cartRepo.GetCartItemByCartIDAndProductID(cartID, req.ProductID, req.VariantID); err == nil {
+		quantity += cartItem.Quantity
+	}
 
-		if err := s.cartRepo.Create(&cart); err != nil {
-			return nil, err
-		}
+	available, err := s.availableStock(cartID, product, variant)
+	if err != nil {
+		return err
+	}
+	if available < quantity {
+		return errors.New("insufficient stock")
 	}
 
-	// Check if item already exists in cart
+	return s.addCartItem(cartID, product.ID, req.VariantID, req.Quantity)
+}
+
+// Reorder copies the products of one of the user's past orders into their
+// cart. Products that are no longer sold are skipped and quantities are cut
+// down to the stock left, and each such line is reported back.
+func (s *CartService) Reorder(userID, orderID uint) (*dto.ReorderResponse, error) {
+	order, err := s.orderRepo.GetOrderByUserIDAndOrderID(userID, orderID)
+	if err != nil {
+		return nil, errors.New("order not found")
+	}
 
-	cartItem, err := s.cartRepo.GetCartItemByCartIDAndProductID(cart.ID, req.ProductID)
+	cart, err := s.getOrCreateCart(userID)
 	if err != nil {
-		// Create new cart item
-		cartItem := models.CartItem{
-			CartID:    cart.ID,
-			ProductID: req.ProductID,
-			Quantity:  req.Quantity,
+		return nil, err
+	}
+
+	inCart := make(map[models.CartLine]int, len(cart.CartItems))
+	for i := range cart.CartItems {
+		inCart[cart.CartItems[i].Line()] = cart.CartItems[i].Quantity
+	}
+
+	adjustments := []dto.ReorderAdjustmentResponse{}
+	for i := range order.OrderItems {
+		item := &order.OrderItems[i]
+		adjustment := dto.ReorderAdjustmentResponse{
+			OrderItemID:       item.ID,
+			ProductID:         item.ProductID,
+			ProductName:       item.DisplayName(),
+			RequestedQuantity: item.Quantity,
+		}
+
+		product, err := s.productRepo.GetProductByID(item.ProductID)
+		var variant *models.ProductVariant
+		if err == nil {
+			variant, err = sellableVariant(product, item.VariantID)
+		}
+		if err != nil || !product.IsActive {
+			adjustment.Status = dto.ReorderStatusSkipped
+			adjustment.Reason = "product is no longer available"
+			adjustments = append(adjustments, adjustment)
+			continue
 		}
-		if err := s.cartRepo.CreateCartItem(&cartItem); err != nil {
-			log.Println(err)
-			_ = err
+
+		// Stock already taken up by the same product in the cart isn't available again
+		line := item.Line()
+		available, err := s.availableStock(cart.ID, product, variant)
+		if err != nil {
+			return nil, err
 		}
-	} else {
-		// Update existing cart item
-		cartItem.Quantity += req.Quantity
-		if cartItem.Quantity > product.Stock {
-			return nil, errors.New("insufficient stock")
+		available -= inCart[line]
+		quantity := min(item.Quantity, available)
+		if quantity <= 0 {
+			adjustment.Status = dto.ReorderStatusSkipped
+			adjustment.Reason = "out of stock"
+			adjustments = append(adjustments, adjustment)
+			continue
 		}
 
-		if err := s.cartRepo.UpdateCartItem(cartItem); err != nil {
-			log.Println(err)
-			_ = err
+		if err := s.addCartItem(cart.ID, product.ID, item.VariantID, quantity); err != nil {
+			return nil, err
 		}
+		inCart[line] += quantity
 
+		if quantity < item.Quantity {
+			adjustment.Status = dto.ReorderStatusAdjusted
+			adjustment.AddedQuantity = quantity
+			adjustment.Reason = fmt.Sprintf("only %d left in stock", available)
+			adjustments = append(adjustments, adjustment)
+		}
 	}
 
-	return s.GetCart(userID)
+	cartResponse, err := s.GetCart(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ReorderResponse{
+		Cart:        *cartResponse,
+		Adjustments: adjustments,
+	}, nil
+}
+
+// addCartItem adds quantity of a product, or of the variant of it, to the
+// cart, on top of any already in it.
+func (s *CartService) addCartItem(cartID, productID uint, variantID *uint, quantity int) error {
+	cartItem, err := s.cartRepo.GetCartItemByCartIDAndProductID(cartID, productID, variantID)
+	if err != nil {
+		return s.cartRepo.CreateCartItem(&models.CartItem{
+			CartID:    cartID,
+			ProductID: productID,
+			VariantID: variantID,
+			Quantity:  quantity,
+		})
+	}
+
+	cartItem.Quantity += quantity
+	return s.cartRepo.UpdateCartItem(cartItem)
+}
+
+// getOrCreateCart returns the user's cart, creating an empty one the first time.
+func (s *CartService) getOrCreateCart(userID uint) (*models.Cart, error) {
+	cart, err := s.cartRepo.GetByUserID(userID)
+	if err == nil {
+		return cart, nil
+	}
+
+	cart = &models.Cart{UserID: &userID}
+	if err := s.cartRepo.Create(cart); err != nil {
+		return nil, err
+	}
+	return cart, nil
 }
 
 func (s *CartService) UpdateCartItem(userID, itemID uint, req *dto.UpdateCartItemRequest) (*dto.CartResponse, error) {
@@ -95,21 +229,35 @@ func (s *CartService) UpdateCartItem(userID, itemID uint, req *dto.UpdateCartIte
 		return nil, errors.New("cart item not found")
 	}
 
+	if err := s.setQuantity(cartItem, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	return s.GetCart(userID)
+}
+
+// setQuantity changes how many of its product the cart item holds.
+func (s *CartService) setQuantity(cartItem *models.CartItem, quantity int) error {
 	product, err := s.productRepo.GetProductByID(cartItem.ProductID)
 	if err != nil {
-		return nil, errors.New("product not found")
+		return errors.New("product not found")
 	}
 
-	if product.Stock < req.Quantity {
-		return nil, errors.New("insufficient stock")
+	variant, err := sellableVariant(product, cartItem.VariantID)
+	if err != nil {
+		return err
 	}
 
-	cartItem.Quantity = req.Quantity
-	if err := s.cartRepo.UpdateCartItem(cartItem); err != nil {
-		return nil, err
+	available, err := s.availableStock(cartItem.CartID, product, variant)
+	if err != nil {
+		return err
+	}
+	if available < quantity {
+		return errors.New("insufficient stock")
 	}
 
-	return s.GetCart(userID)
+	cartItem.Quantity = quantity
+	return s.cartRepo.UpdateCartItem(cartItem)
 }
 
 func (s *CartService) RemoveFromCart(userID, itemID uint) error {
@@ -120,33 +268,203 @@ func (s *CartService) RemoveFromCart(userID, itemID uint) error {
 	return s.cartRepo.RemoveCartItemFromCart(userID, itemID)
 }
 
-func (s *CartService) convertToCartResponse(cart *models.Cart) *dto.CartResponse {
+// ReserveStock holds the stock for everything in the user's cart while they
+// check out, for the configured reservation TTL. Reserving again replaces the
+// previous reservation and extends it.
+func (s *CartService) ReserveStock(userID uint) (*dto.StockReservationResponse, error) {
+	cart, err := s.cartRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, errors.New("cart is empty")
+	}
+
+	return s.reserveStock(cart.ID)
+}
+
+// ReserveGuestStock is ReserveStock for a guest cart.
+func (s *CartService) ReserveGuestStock(cartID uint) (*dto.StockReservationResponse, error) {
+	if _, err := s.cartRepo.GetGuestCart(cartID); err != nil {
+		return nil, errors.New("cart not found")
+	}
+
+	return s.reserveStock(cartID)
+}
+
+func (s *CartService) reserveStock(cartID uint) (*dto.StockReservationResponse, error) {
+	expiresAt := time.Now().Add(s.config.Checkout.ReservationTTL)
+	reservations, err := s.reservationRepo.ReserveCart(cartID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.StockReservationItemResponse, len(reservations))
+	for i := range reservations {
+		items[i] = dto.StockReservationItemResponse{
+			ProductID:   reservations[i].ProductID,
+			VariantID:   reservations[i].VariantID,
+			ProductName: reservations[i].Product.DisplayName(reservations[i].Variant),
+			Quantity:    reservations[i].Quantity,
+		}
+	}
+
+	return &dto.StockReservationResponse{
+		ExpiresAt: expiresAt,
+		Items:     items,
+	}, nil
+}
+
+// CreateGuestCart starts an empty cart for a shopper without an account. The
+// returned token is the only way to reach the cart again.
+func (s *CartService) CreateGuestCart() (*dto.GuestCartResponse, error) {
+	cart := models.Cart{}
+	if err := s.cartRepo.Create(&cart); err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GenerateCartToken(&s.config.JWT, cart.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cartResponse, err := s.GetGuestCart(cart.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GuestCartResponse{
+		CartToken: token,
+		Cart:      *cartResponse,
+	}, nil
+}
+
+// GetGuestCart returns a guest cart. Guests have no address yet, so tax is
+// only estimated once they check out.
+func (s *CartService) GetGuestCart(cartID uint) (*dto.CartResponse, error) {
+	cart, err := s.cartRepo.GetGuestCart(cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	calculator, err := s.taxService.CalculatorFor("")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertToCartResponse(cart, tax.Address{}, calculator), nil
+}
+
+func (s *CartService) AddToGuestCart(cartID uint, req *dto.AddToCartRequest) (*dto.CartResponse, error) {
+	if _, err := s.cartRepo.GetGuestCart(cartID); err != nil {
+		return nil, errors.New("cart not found")
+	}
+
+	if err := s.addToCart(cartID, req); err != nil {
+		return nil, err
+	}
+
+	return s.GetGuestCart(cartID)
+}
+
+func (s *CartService) UpdateGuestCartItem(cartID, itemID uint, req *dto.UpdateCartItemRequest) (*dto.CartResponse, error) {
+	if _, err := s.cartRepo.GetGuestCart(cartID); err != nil {
+		return nil, errors.New("cart not found")
+	}
+
+	cartItem, err := s.cartRepo.GetCartItemByCartItemIDAndCartID(itemID, cartID)
+	if err != nil {
+		return nil, errors.New("cart item not found")
+	}
+
+	if err := s.setQuantity(cartItem, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	return s.GetGuestCart(cartID)
+}
+
+func (s *CartService) RemoveFromGuestCart(cartID, itemID uint) error {
+	if _, err := s.cartRepo.GetGuestCart(cartID); err != nil {
+		return errors.New("cart not found")
+	}
+
+	return s.cartRepo.RemoveCartItemFromCartByID(cartID, itemID)
+}
+
+// ApplyCoupon attaches a coupon to the user's cart after checking that it can
+// be used on the cart as it is now. The coupon is checked again at checkout.
+func (s *CartService) ApplyCoupon(userID uint, req *dto.ApplyCouponRequest) (*dto.CartResponse, error) {
+	cart, err := s.cartRepo.GetByUserID(userID)
+	if err != nil || len(cart.CartItems) == 0 {
+		return nil, errors.New("cart is empty")
+	}
+
+	coupon, err := s.couponRepo.GetByCode(normalizeCouponCode(req.Code))
+	if err != nil {
+		return nil, errors.New("coupon not found")
+	}
+
+	if err := coupon.CheckAvailable(time.Now()); err != nil {
+		return nil, err
+	}
+
+	if coupon.UsageLimitPerUser > 0 {
+		used, err := s.couponRepo.CountRedemptionsByUser(coupon.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if used >= int64(coupon.UsageLimitPerUser) {
+			return nil, errors.New("coupon usage limit reached")
+		}
+	}
+
+	if _, err := coupon.Discount(cart.CartItems); err != nil {
+		return nil, err
+	}
+
+	if err := s.cartRepo.SetCoupon(cart.ID, &coupon.ID); err != nil {
+		return nil, err
+	}
+
+	return s.GetCart(userID)
+}
+
+func (s *CartService) RemoveCoupon(userID uint) (*dto.CartResponse, error) {
+	cart, err := s.cartRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, errors.New("cart not found")
+	}
+
+	if err := s.cartRepo.SetCoupon(cart.ID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.GetCart(userID)
+}
+
+// convertToCartResponse prices the cart, including the coupon discount and the
+// tax for taxAddress worked out by calculator.
+func (s *CartService) convertToCartResponse(cart *models.Cart, taxAddress tax.Address, calculator *tax.Calculator) *dto.CartResponse {
 
 	cartItems := make([]dto.CartItemResponse, len(cart.CartItems)) // memory allocation
-	var total float64
+	taxLines := make([]tax.Line, len(cart.CartItems))
 
 	for i := range cart.CartItems {
-		subtotal := float64(cart.CartItems[i].Quantity) * cart.CartItems[i].Product.Price
-		total += subtotal
+		subtotal := cart.CartItems[i].UnitPrice().Mul(cart.CartItems[i].Quantity)
+		taxLines[i] = tax.Line{
+			Amount:   subtotal,
+			Discount: money.New(0),
+			TaxClass: cart.CartItems[i].Product.TaxClass,
+		}
+
+		var variant *dto.ProductVariantResponse
+		if cart.CartItems[i].Variant != nil {
+			response := convertToProductVariantResponse(&cart.CartItems[i].Product, cart.CartItems[i].Variant)
+			variant = &response
+		}
 
 		cartItems[i] = dto.CartItemResponse{
-			ID: cart.CartItems[i].ID,
-			Product: dto.ProductResponse{
-				ID:          cart.CartItems[i].Product.ID,
-				CategoryID:  cart.CartItems[i].Product.CategoryID,
-				Name:        cart.CartItems[i].Product.Name,
-				Description: cart.CartItems[i].Product.Description,
-				Price:       cart.CartItems[i].Product.Price,
-				Stock:       cart.CartItems[i].Product.Stock,
-				SKU:         cart.CartItems[i].Product.SKU,
-				IsActive:    cart.CartItems[i].Product.IsActive,
-				Category: dto.CategoryResponse{
-					ID:          cart.CartItems[i].Product.Category.ID,
-					Name:        cart.CartItems[i].Product.Category.Name,
-					Description: cart.CartItems[i].Product.Category.Description,
-					IsActive:    cart.CartItems[i].Product.Category.IsActive,
-				},
-			},
+			ID:        cart.CartItems[i].ID,
+			Product:   convertToProductResponse(&cart.CartItems[i].Product),
+			Variant:   variant,
 			Quantity:  cart.CartItems[i].Quantity,
 			Subtotal:  subtotal,
 			CreatedAt: cart.CartItems[i].CreatedAt,
@@ -154,12 +472,90 @@ func (s *CartService) convertToCartResponse(cart *models.Cart) *dto.CartResponse
 		}
 	}
 
+	discounts := []dto.CartDiscountResponse{}
+	if cart.Coupon != nil {
+		discount := dto.CartDiscountResponse{
+			Code:        cart.Coupon.Code,
+			Description: cart.Coupon.Description,
+			Type:        string(cart.Coupon.Type),
+			Amount:      money.New(0),
+		}
+
+		// Show why a coupon stopped applying instead of silently dropping it
+		err := cart.Coupon.CheckAvailable(time.Now())
+		if err == nil {
+			var lineDiscounts []money.Money
+			if lineDiscounts, err = cart.Coupon.LineDiscounts(cart.CartItems); err == nil {
+				for i := range taxLines {
+					taxLines[i].Discount = lineDiscounts[i]
+					discount.Amount = discount.Amount.Add(lineDiscounts[i])
+				}
+			}
+		}
+		if err != nil {
+			discount.Message = err.Error()
+		}
+
+		discounts = append(discounts, discount)
+	}
+
+	taxResult := calculator.Calculate(taxAddress, taxLines)
+	for i := range cartItems {
+		cartItems[i].TaxAmount = taxResult.Lines[i].Tax
+	}
+
 	return &dto.CartResponse{
-		ID:        cart.ID,
-		UserID:    cart.UserID,
-		CartItems: cartItems,
-		Total:     total,
-		CreatedAt: cart.CreatedAt,
-		UpdatedAt: cart.UpdatedAt,
+		ID:               cart.ID,
+		UserID:           cart.UserID,
+		CartItems:        cartItems,
+		Subtotal:         taxResult.Subtotal,
+		Discounts:        discounts,
+		TaxTotal:         taxResult.Tax,
+		Total:            taxResult.Total,
+		PricesIncludeTax: calculator.PricesIncludeTax(),
+		CreatedAt:        cart.CreatedAt,
+		UpdatedAt:        cart.UpdatedAt,
+	}
+}
+
+// sellableVariant returns the variant of product to sell. Products with
+// variants are only sold as one of their active variants, and products
+// without them only on their own.
+func sellableVariant(product *models.Product, variantID *uint) (*models.ProductVariant, error) {
+	if !product.HasVariants() {
+		if variantID != nil {
+			return nil, errors.New("product has no variants")
+		}
+		return nil, nil
+	}
+
+	if variantID == nil {
+		return nil, errors.New("a variant of the product must be chosen")
+	}
+
+	variant := product.Variant(*variantID)
+	if variant == nil || !variant.IsActive {
+		return nil, errVariantNotFound
+	}
+	return variant, nil
+}
+
+// availableStock is the stock of the variant when there is one, else of the
+// product, that isn't reserved for other carts. It's the stock ReserveStock
+// can hold for the cart, so the cart never takes more than that. The
+// product must be loaded with its reserved stock.
+func (s *CartService) availableStock(cartID uint, product *models.Product, variant *models.ProductVariant) (int, error) {
+	line := models.CartLine{ProductID: product.ID}
+	available := product.AvailableStock()
+	if variant != nil {
+		line.VariantID = variant.ID
+		available = variant.AvailableStock()
+	}
+
+	// The cart's own reservation is counted in the reserved stock, but is stock the cart holds
+	reserved, err := s.reservationRepo.GetCartReservedQuantity(cartID, line)
+	if err != nil {
+		return 0, err
 	}
+	return available + reserved, nil
 }
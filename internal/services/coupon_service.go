@@ -0,0 +1,152 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+var _ CouponServiceInterface = (*CouponService)(nil)
+
+type CouponService struct {
+	couponRepo repositories.CouponRepositoryInterface
+}
+
+func NewCouponService(couponRepo repositories.CouponRepositoryInterface) *CouponService {
+	return &CouponService{couponRepo: couponRepo}
+}
+
+func (s *CouponService) CreateCoupon(req *dto.CreateCouponRequest) (*dto.CouponResponse, error) {
+	coupon := models.Coupon{
+		Code:              normalizeCouponCode(req.Code),
+		Description:       req.Description,
+		Type:              models.CouponType(req.Type),
+		PercentOff:        req.PercentOff,
+		AmountOff:         req.AmountOff,
+		MinCartValue:      req.MinCartValue,
+		StartsAt:          req.StartsAt,
+		ExpiresAt:         req.ExpiresAt,
+		UsageLimit:        req.UsageLimit,
+		UsageLimitPerUser: req.UsageLimitPerUser,
+		IsActive:          true,
+	}
+
+	switch coupon.Type {
+	case models.CouponTypePercentage:
+		if coupon.PercentOff <= 0 {
+			return nil, errors.New("percentage coupons need a percent_off between 1 and 100")
+		}
+	case models.CouponTypeFixedAmount:
+		if !coupon.AmountOff.IsPositive() {
+			return nil, errors.New("fixed amount coupons need a positive amount_off")
+		}
+	}
+
+	if coupon.MinCartValue.IsNegative() {
+		return nil, errors.New("min_cart_value cannot be negative")
+	}
+
+	if coupon.StartsAt != nil && coupon.ExpiresAt != nil && !coupon.ExpiresAt.After(*coupon.StartsAt) {
+		return nil, errors.New("expires_at must be after starts_at")
+	}
+
+	for _, productID := range req.ProductIDs {
+		coupon.Products = append(coupon.Products, models.Product{ID: productID})
+	}
+	for _, categoryID := range req.CategoryIDs {
+		coupon.Categories = append(coupon.Categories, models.Category{ID: categoryID})
+	}
+
+	if _, err := s.couponRepo.GetByCode(coupon.Code); err == nil {
+		return nil, errors.New("coupon code already exists")
+	}
+
+	if err := s.couponRepo.Create(&coupon); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToCouponResponse(&coupon)
+	return &response, nil
+}
+
+func (s *CouponService) GetCoupons(page, limit int) ([]dto.CouponResponse, *utils.PaginationMeta, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	offset := (page - 1) * limit
+
+	total, err := s.couponRepo.GetCouponsCount()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coupons, err := s.couponRepo.GetCoupons(offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := make([]dto.CouponResponse, len(coupons))
+	for i := range coupons {
+		response[i] = s.convertToCouponResponse(&coupons[i])
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	meta := &utils.PaginationMeta{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return response, meta, nil
+}
+
+func (s *CouponService) convertToCouponResponse(coupon *models.Coupon) dto.CouponResponse {
+	productIDs := make([]uint, len(coupon.Products))
+	for i := range coupon.Products {
+		productIDs[i] = coupon.Products[i].ID
+	}
+
+	categoryIDs := make([]uint, len(coupon.Categories))
+	for i := range coupon.Categories {
+		categoryIDs[i] = coupon.Categories[i].ID
+	}
+
+	return dto.CouponResponse{
+		ID:                coupon.ID,
+		Code:              coupon.Code,
+		Description:       coupon.Description,
+		Type:              string(coupon.Type),
+		PercentOff:        coupon.PercentOff,
+		AmountOff:         coupon.AmountOff,
+		MinCartValue:      coupon.MinCartValue,
+		StartsAt:          coupon.StartsAt,
+		ExpiresAt:         coupon.ExpiresAt,
+		UsageLimit:        coupon.UsageLimit,
+		UsageLimitPerUser: coupon.UsageLimitPerUser,
+		TimesUsed:         coupon.TimesUsed,
+		IsActive:          coupon.IsActive,
+		ProductIDs:        productIDs,
+		CategoryIDs:       categoryIDs,
+		CreatedAt:         coupon.CreatedAt,
+		UpdatedAt:         coupon.UpdatedAt,
+	}
+}
+
+// normalizeCouponCode makes coupon codes case-insensitive.
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+// idempotencyKeyTTL is how long a stored response can be replayed.
+const idempotencyKeyTTL = 24 * time.Hour
+
+var (
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still being processed")
+)
+
+var _ IdempotencyServiceInterface = (*IdempotencyService)(nil)
+
+type IdempotencyService struct {
+	idempotencyRepo repositories.IdempotencyRepositoryInterface
+}
+
+func NewIdempotencyService(idempotencyRepo repositories.IdempotencyRepositoryInterface) *IdempotencyService {
+	return &IdempotencyService{idempotencyRepo: idempotencyRepo}
+}
+
+// Start reserves key for its owner. It returns nil when the request should run,
+// or the stored response when the same request was already completed.
+func (s *IdempotencyService) Start(owner models.IdempotencyOwner, key, requestHash string) (*dto.IdempotentResponse, error) {
+	record := models.IdempotencyKey{
+		UserID:      owner.UserID,
+		CartID:      owner.CartID,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   time.Now().Add(idempotencyKeyTTL),
+	}
+
+	created, err := s.idempotencyRepo.CreateIfAbsent(&record)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		return nil, nil
+	}
+
+	existing, err := s.idempotencyRepo.GetByOwnerAndKey(owner, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.ExpiresAt.Before(time.Now()) {
+		if err := s.idempotencyRepo.Delete(existing.ID); err != nil {
+			return nil, err
+		}
+		return s.Start(owner, key, requestHash)
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, ErrIdempotencyKeyReused
+	}
+
+	if existing.StatusCode == 0 {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+
+	return &dto.IdempotentResponse{
+		StatusCode:  existing.StatusCode,
+		ContentType: existing.ContentType,
+		Body:        existing.ResponseBody,
+	}, nil
+}
+
+// Complete stores the response of the request started with key.
+func (s *IdempotencyService) Complete(owner models.IdempotencyOwner, key string, response *dto.IdempotentResponse) error {
+	record, err := s.idempotencyRepo.GetByOwnerAndKey(owner, key)
+	if err != nil {
+		return err
+	}
+
+	record.StatusCode = response.StatusCode
+	record.ContentType = response.ContentType
+	record.ResponseBody = response.Body
+
+	return s.idempotencyRepo.Update(record)
+}
+
+// Release forgets key so the client can retry, e.g. after a server error.
+func (s *IdempotencyService) Release(owner models.IdempotencyOwner, key string) error {
+	record, err := s.idempotencyRepo.GetByOwnerAndKey(owner, key)
+	if err != nil {
+		return err
+	}
+
+	return s.idempotencyRepo.Delete(record.ID)
+}
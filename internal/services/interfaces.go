@@ -4,6 +4,9 @@ import (
 	"mime/multipart"
 
 	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/tax"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
 )
 
@@ -22,17 +25,29 @@ type UserServiceInterface interface {
 type ProductServiceInterface interface {
 	CreateCategory(req *dto.CreateCategoryRequest) (*dto.CategoryResponse, error)
 	GetCategories() ([]dto.CategoryResponse, error)
+	GetCategoryTree() ([]dto.CategoryTreeResponse, error)
+	GetChildCategories(id uint) ([]dto.CategoryResponse, error)
+	GetCategoryBreadcrumbs(id uint) ([]dto.CategoryBreadcrumbResponse, error)
 	UpdateCategory(id uint, req *dto.UpdateCategoryRequest) (*dto.CategoryResponse, error)
 	DeleteCategory(id uint) error
 
 	CreateProduct(req *dto.CreateProductRequest) (*dto.ProductResponse, error)
-	GetProducts(page, limit int) ([]dto.ProductResponse, *utils.PaginationMeta, error)
+	GetProducts(filter *dto.ProductFilter, page, limit int) ([]dto.ProductResponse, *utils.PaginationMeta, error)
 	GetProduct(id uint) (*dto.ProductResponse, error)
 	UpdateProduct(id uint, req *dto.UpdateProductRequest) (*dto.ProductResponse, error)
 	DeleteProduct(id uint) error
 
-	AddProductImage(productID uint, url, altText string) error
-	SearchProducts(req *dto.SearchProductsRequest) ([]dto.ProductSearchResult, *utils.PaginationMeta, error)
+	AddProductImage(productID uint, variantID *uint, url, altText string) error
+	CreateProductOption(productID uint, req *dto.CreateProductOptionRequest) (*dto.ProductResponse, error)
+	CreateProductVariant(productID uint, req *dto.CreateProductVariantRequest) (*dto.ProductVariantResponse, error)
+	UpdateProductVariant(productID, variantID uint, req *dto.UpdateProductVariantRequest) (*dto.ProductVariantResponse, error)
+	DeleteProductVariant(productID, variantID uint) error
+	SearchProducts(req *dto.SearchProductsRequest) ([]dto.ProductSearchResult, *dto.SearchFacetsResponse, *utils.PaginationMeta, error)
+	SuggestSearch(req *dto.SearchSuggestRequest) (*dto.SearchSuggestionsResponse, error)
+
+	GetSearchSynonyms() ([]dto.SearchSynonymResponse, error)
+	CreateSearchSynonym(req *dto.CreateSearchSynonymRequest) (*dto.SearchSynonymResponse, error)
+	DeleteSearchSynonym(id uint) error
 }
 
 type CartServiceInterface interface {
@@ -40,14 +55,108 @@ type CartServiceInterface interface {
 	AddToCart(userID uint, req *dto.AddToCartRequest) (*dto.CartResponse, error)
 	UpdateCartItem(userID, itemID uint, req *dto.UpdateCartItemRequest) (*dto.CartResponse, error)
 	RemoveFromCart(userID, itemID uint) error
+	ApplyCoupon(userID uint, req *dto.ApplyCouponRequest) (*dto.CartResponse, error)
+	RemoveCoupon(userID uint) (*dto.CartResponse, error)
+	Reorder(userID, orderID uint) (*dto.ReorderResponse, error)
+	ReserveStock(userID uint) (*dto.StockReservationResponse, error)
+	ReserveGuestStock(cartID uint) (*dto.StockReservationResponse, error)
+	CreateGuestCart() (*dto.GuestCartResponse, error)
+	GetGuestCart(cartID uint) (*dto.CartResponse, error)
+	AddToGuestCart(cartID uint, req *dto.AddToCartRequest) (*dto.CartResponse, error)
+	UpdateGuestCartItem(cartID, itemID uint, req *dto.UpdateCartItemRequest) (*dto.CartResponse, error)
+	RemoveFromGuestCart(cartID, itemID uint) error
+}
+
+type WishlistServiceInterface interface {
+	GetWishlists(userID uint) ([]dto.WishlistResponse, error)
+	GetWishlist(userID, wishlistID uint) (*dto.WishlistResponse, error)
+	GetSharedWishlist(token string) (*dto.WishlistResponse, error)
+	CreateWishlist(userID uint, req *dto.CreateWishlistRequest) (*dto.WishlistResponse, error)
+	UpdateWishlist(userID, wishlistID uint, req *dto.UpdateWishlistRequest) (*dto.WishlistResponse, error)
+	DeleteWishlist(userID, wishlistID uint) error
+	AddItem(userID, wishlistID uint, req *dto.AddWishlistItemRequest) (*dto.WishlistResponse, error)
+	RemoveItem(userID, wishlistID, itemID uint) (*dto.WishlistResponse, error)
+	MoveToCart(userID, wishlistID, itemID uint, req *dto.MoveWishlistItemRequest) (*dto.CartResponse, error)
+	ShareWishlist(userID, wishlistID uint) (*dto.WishlistResponse, error)
+	UnshareWishlist(userID, wishlistID uint) (*dto.WishlistResponse, error)
+}
+
+type AddressServiceInterface interface {
+	GetAddresses(userID uint) ([]dto.AddressResponse, error)
+	GetAddress(userID, addressID uint) (*dto.AddressResponse, error)
+	CreateAddress(userID uint, req *dto.CreateAddressRequest) (*dto.AddressResponse, error)
+	UpdateAddress(userID, addressID uint, req *dto.UpdateAddressRequest) (*dto.AddressResponse, error)
+	DeleteAddress(userID, addressID uint) error
+}
+
+type TaxServiceInterface interface {
+	CalculatorFor(country string) (*tax.Calculator, error)
+	GetTaxRates() ([]dto.TaxRateResponse, error)
+	CreateTaxRate(req *dto.CreateTaxRateRequest) (*dto.TaxRateResponse, error)
+	DeleteTaxRate(id uint) error
+}
+
+type ShippingServiceInterface interface {
+	GetShippingOptions(userID uint, req *dto.ShippingOptionsRequest) ([]dto.ShippingOptionResponse, error)
+	GetGuestShippingOptions(cartID uint, req *dto.GuestShippingOptionsRequest) ([]dto.ShippingOptionResponse, error)
+	ChooseMethod(userID uint, address *models.Address, methodID *uint) (*models.ShippingMethod, error)
+	ChooseGuestMethod(cartID uint, address *models.Address, methodID *uint) (*models.ShippingMethod, error)
+	GetZones() ([]dto.ShippingZoneResponse, error)
+	CreateZone(req *dto.CreateShippingZoneRequest) (*dto.ShippingZoneResponse, error)
+	CreateMethod(zoneID uint, req *dto.CreateShippingMethodRequest) (*dto.ShippingMethodResponse, error)
+}
+
+type CouponServiceInterface interface {
+	CreateCoupon(req *dto.CreateCouponRequest) (*dto.CouponResponse, error)
+	GetCoupons(page, limit int) ([]dto.CouponResponse, *utils.PaginationMeta, error)
+}
+
+type PaymentServiceInterface interface {
+	Authorize(order *models.Order, token string) (*models.Payment, error)
+	Capture(orderID uint) error
+	Release(orderID uint) error
+	Refund(orderID uint, amount, refundedTotal money.Money) error
+	HandleWebhook(payload []byte, signature string) (*models.Payment, models.PaymentStatus, error)
 }
 
 type OrderServiceInterface interface {
-	CreateOrder(userID uint) (*dto.OrderResponse, error)
+	CreateOrder(userID uint, req *dto.CreateOrderRequest) (*dto.OrderResponse, error)
+	CreateGuestOrder(cartID uint, req *dto.GuestCheckoutRequest) (*dto.GuestOrderResponse, error)
+	GetGuestOrder(req *dto.GuestOrderLookupRequest) (*dto.OrderResponse, error)
+	ClaimGuestOrder(userID uint, req *dto.GuestOrderLookupRequest) (*dto.OrderResponse, error)
 	GetOrders(userID uint, page, limit int) ([]dto.OrderResponse, *utils.PaginationMeta, error)
 	GetOrder(userID, orderID uint) (*dto.OrderResponse, error)
+	GetAllOrders(filter *dto.AdminOrderFilter, page, limit int) ([]dto.OrderResponse, *utils.PaginationMeta, error)
+	GetOrderByID(orderID uint) (*dto.OrderResponse, error)
+	UpdateOrderStatus(orderID uint, req *dto.UpdateOrderStatusRequest) (*dto.OrderResponse, error)
+	CancelOrder(userID, orderID uint, req *dto.CancelOrderRequest) (*dto.OrderResponse, error)
+	CreateShipment(orderID uint, req *dto.CreateShipmentRequest) (*dto.OrderResponse, error)
+	MarkShipmentDelivered(shipmentID uint) (*dto.OrderResponse, error)
+	HandlePaymentWebhook(payload []byte, signature string) error
+}
+
+type InvoiceServiceInterface interface {
+	GetInvoice(userID, orderID uint) (*dto.InvoiceFile, error)
+	GetAnyInvoice(orderID uint) (*dto.InvoiceFile, error)
+}
+
+type ReturnServiceInterface interface {
+	RequestReturn(userID, orderID uint, req *dto.CreateReturnRequest) (*dto.ReturnResponse, error)
+	GetReturns(userID uint, page, limit int) ([]dto.ReturnResponse, *utils.PaginationMeta, error)
+	GetReturn(userID, returnID uint) (*dto.ReturnResponse, error)
+	GetAllReturns(status string, page, limit int) ([]dto.ReturnResponse, *utils.PaginationMeta, error)
+	ApproveReturn(returnID uint) (*dto.ReturnResponse, error)
+	RejectReturn(returnID uint, req *dto.RejectReturnRequest) (*dto.ReturnResponse, error)
+	ReceiveReturn(returnID uint) (*dto.ReturnResponse, error)
+	RefundReturn(returnID uint, req *dto.RefundReturnRequest) (*dto.ReturnResponse, error)
 }
 
 type UploadServiceInterface interface {
 	UploadProductImage(productID uint, file *multipart.FileHeader) (string, error)
 }
+
+type IdempotencyServiceInterface interface {
+	Start(owner models.IdempotencyOwner, key, requestHash string) (*dto.IdempotentResponse, error)
+	Complete(owner models.IdempotencyOwner, key string, response *dto.IdempotentResponse) error
+	Release(owner models.IdempotencyOwner, key string) error
+}
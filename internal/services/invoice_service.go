@@ -0,0 +1,119 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/interfaces"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/invoice"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+var _ InvoiceServiceInterface = (*InvoiceService)(nil)
+
+var (
+	ErrOrderNotFound    = errors.New("order not found")
+	ErrInvoiceNotIssued = errors.New("an invoice is issued once the order is confirmed")
+)
+
+type InvoiceService struct {
+	orderRepo   repositories.OrderRepositoryInterface
+	invoiceRepo repositories.InvoiceRepositoryInterface
+	storage     interfaces.UploadProvider
+	seller      invoice.Seller
+}
+
+// NewInvoiceService creates the invoice service. Invoice PDFs are kept in
+// storage, which must not be publicly served: invoice numbers are sequential
+// and the PDFs hold the customer's name and address.
+func NewInvoiceService(orderRepo repositories.OrderRepositoryInterface,
+	invoiceRepo repositories.InvoiceRepositoryInterface,
+	storage interfaces.UploadProvider,
+	seller invoice.Seller) *InvoiceService {
+	return &InvoiceService{
+		orderRepo:   orderRepo,
+		invoiceRepo: invoiceRepo,
+		storage:     storage,
+		seller:      seller,
+	}
+}
+
+// GetInvoice returns the invoice PDF of one of the user's orders.
+func (s *InvoiceService) GetInvoice(userID, orderID uint) (*dto.InvoiceFile, error) {
+	order, err := s.orderRepo.GetOrderByUserIDAndOrderID(userID, orderID)
+	if err != nil {
+		return nil, ErrOrderNotFound
+	}
+
+	return s.invoiceFile(order)
+}
+
+// GetAnyInvoice returns the invoice PDF of any user's order.
+func (s *InvoiceService) GetAnyInvoice(orderID uint) (*dto.InvoiceFile, error) {
+	order, err := s.orderRepo.GetOrderByID(orderID)
+	if err != nil {
+		return nil, ErrOrderNotFound
+	}
+
+	return s.invoiceFile(order)
+}
+
+func (s *InvoiceService) invoiceFile(order *models.Order) (*dto.InvoiceFile, error) {
+	if order.Invoice == nil {
+		return nil, ErrInvoiceNotIssued
+	}
+
+	content, err := s.document(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.InvoiceFile{
+		FileName:    order.Invoice.Number + ".pdf",
+		ContentType: invoice.ContentType,
+		Content:     content,
+	}, nil
+}
+
+// document returns the stored PDF of the order's invoice. The PDF is rendered
+// and stored on first download, and every later download returns the stored
+// file unchanged even if the order or seller details change afterwards.
+func (s *InvoiceService) document(order *models.Order) ([]byte, error) {
+	issued := order.Invoice
+	if issued.FilePath != "" {
+		file, err := s.storage.OpenFile(issued.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open invoice %s: %w", issued.Number, err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.Printf("failed to close invoice %s: %v", issued.Number, err)
+			}
+		}()
+
+		return io.ReadAll(file)
+	}
+
+	content, err := invoice.Render(s.seller, issued, order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render invoice %s: %w", issued.Number, err)
+	}
+
+	// The path only depends on the number, so two first downloads racing
+	// each other store the same document at the same place
+	path := fmt.Sprintf("invoices/%s.pdf", issued.Number)
+	if err := s.storage.SaveFile(bytes.NewReader(content), path, invoice.ContentType); err != nil {
+		return nil, fmt.Errorf("failed to store invoice %s: %w", issued.Number, err)
+	}
+
+	if err := s.invoiceRepo.UpdateFilePath(issued, path); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
@@ -1,10 +1,15 @@
 package services
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/events"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/notifications"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
 )
@@ -12,23 +17,221 @@ import (
 var _ OrderServiceInterface = (*OrderService)(nil)
 
 type OrderService struct {
-	orderRepo repositories.OrderRepositoryInterface
+	orderRepo       repositories.OrderRepositoryInterface
+	addressRepo     repositories.AddressRepositoryInterface
+	shippingService ShippingServiceInterface
+	taxService      TaxServiceInterface
+	paymentService  PaymentServiceInterface
+	eventPublisher  events.Publisher
 }
 
 // NewOrderService creates the order service type
-func NewOrderService(orderRepo repositories.OrderRepositoryInterface) *OrderService {
-	return &OrderService{orderRepo: orderRepo}
+func NewOrderService(orderRepo repositories.OrderRepositoryInterface,
+	addressRepo repositories.AddressRepositoryInterface,
+	shippingService ShippingServiceInterface,
+	taxService TaxServiceInterface,
+	paymentService PaymentServiceInterface,
+	eventPublisher events.Publisher) *OrderService {
+	return &OrderService{
+		orderRepo:       orderRepo,
+		addressRepo:     addressRepo,
+		shippingService: shippingService,
+		taxService:      taxService,
+		paymentService:  paymentService,
+		eventPublisher:  eventPublisher,
+	}
+}
+
+func (s *OrderService) CreateOrder(userID uint, req *dto.CreateOrderRequest) (*dto.OrderResponse, error) {
+	shippingAddress, billingAddress, err := s.resolveAddresses(userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	shippingMethod, err := s.shippingService.ChooseMethod(userID, shippingAddress, req.ShippingMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxCalculator, err := s.taxService.CalculatorFor(shippingAddress.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.orderRepo.CreateOrder(userID, repositories.CreateOrderParams{
+		ShippingAddress: shippingAddress.Snapshot(),
+		BillingAddress:  billingAddress.Snapshot(),
+		ShippingMethod:  shippingMethod,
+		TaxCalculator:   taxCalculator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.authorizePayment(order, req.PaymentToken, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.convertToOrderResponse(order)
+	return &response, nil
+
 }
 
-func (s *OrderService) CreateOrder(userID uint) (*dto.OrderResponse, error) {
-	order, err := s.orderRepo.CreateOrder(userID)
+// CreateGuestOrder checks out a guest cart. The order is stored without a
+// user and can be looked up with the email and the access token returned
+// here, which is also what it takes to claim the order into an account.
+func (s *OrderService) CreateGuestOrder(cartID uint, req *dto.GuestCheckoutRequest) (*dto.GuestOrderResponse, error) {
+	shippingAddress := guestAddress(&req.ShippingAddress)
+	billingAddress := shippingAddress
+	if req.BillingAddress != nil {
+		billingAddress = guestAddress(req.BillingAddress)
+	}
+
+	shippingMethod, err := s.shippingService.ChooseGuestMethod(cartID, shippingAddress, req.ShippingMethodID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxCalculator, err := s.taxService.CalculatorFor(shippingAddress.Country)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, accessTokenHash, err := utils.GenerateAccessToken()
 	if err != nil {
 		return nil, err
 	}
 
+	order, err := s.orderRepo.CreateGuestOrder(cartID, repositories.CreateOrderParams{
+		ShippingAddress:      shippingAddress.Snapshot(),
+		BillingAddress:       billingAddress.Snapshot(),
+		ShippingMethod:       shippingMethod,
+		TaxCalculator:        taxCalculator,
+		GuestEmail:           strings.ToLower(req.Email),
+		GuestAccessTokenHash: accessTokenHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.authorizePayment(order, req.PaymentToken, &cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GuestOrderResponse{
+		AccessToken: accessToken,
+		Order:       s.convertToOrderResponse(order),
+	}, nil
+}
+
+// GetGuestOrder looks up a guest order by the email it was placed with and its access token.
+func (s *OrderService) GetGuestOrder(req *dto.GuestOrderLookupRequest) (*dto.OrderResponse, error) {
+	order, err := s.orderRepo.GetGuestOrder(req.Email, utils.HashAccessToken(req.AccessToken))
+	if err != nil {
+		return nil, ErrOrderNotFound
+	}
+
 	response := s.convertToOrderResponse(order)
 	return &response, nil
+}
+
+// ClaimGuestOrder moves a guest order to the user's account. Knowing the
+// email isn't enough; the order's access token proves the order is theirs.
+func (s *OrderService) ClaimGuestOrder(userID uint, req *dto.GuestOrderLookupRequest) (*dto.OrderResponse, error) {
+	order, err := s.orderRepo.ClaimGuestOrder(userID, req.Email, utils.HashAccessToken(req.AccessToken))
+	if err != nil {
+		return nil, ErrOrderNotFound
+	}
 
+	response := s.convertToOrderResponse(order)
+	return &response, nil
+}
+
+// authorizePayment pays for a new order. The order is only confirmed once its
+// payment is authorized. A pending payment leaves the order pending until the
+// provider's webhook arrives. When the payment doesn't go through the order
+// is cancelled and its items go back in the cart, which is guestCartID for
+// guest orders. An order that costs nothing, such as one fully covered by a
+// coupon, has nothing to authorize and is confirmed straight away.
+func (s *OrderService) authorizePayment(order *models.Order, paymentToken string, guestCartID *uint) (*models.Order, error) {
+	if !order.TotalAmount.IsPositive() {
+		return s.confirmOrder(order.ID)
+	}
+
+	payment, err := s.paymentService.Authorize(order, paymentToken)
+	if err != nil {
+		s.cancelUnpaidOrder(order.ID, guestCartID, "payment could not be processed")
+		return nil, fmt.Errorf("failed to process payment: %w", err)
+	}
+
+	switch payment.Status {
+	case models.PaymentStatusAuthorized:
+		return s.confirmOrder(order.ID)
+	case models.PaymentStatusFailed:
+		s.cancelUnpaidOrder(order.ID, guestCartID, "payment failed: "+payment.FailureReason)
+		return nil, fmt.Errorf("payment declined: %s", payment.FailureReason)
+	default:
+		order.Payments = append(order.Payments, *payment)
+		return order, nil
+	}
+}
+
+// confirmOrder moves a new order on to confirmed once it's paid for.
+func (s *OrderService) confirmOrder(orderID uint) (*models.Order, error) {
+	confirmed, previousStatus, err := s.orderRepo.UpdateOrderStatus(orderID, models.OrderStatusConfirmed, "")
+	if err != nil {
+		return nil, err
+	}
+	s.publishStatusChanged(confirmed, previousStatus)
+	return confirmed, nil
+}
+
+// guestAddress turns an address entered at guest checkout into an address
+// that is not stored in any address book.
+func guestAddress(req *dto.GuestAddressRequest) *models.Address {
+	return &models.Address{
+		FirstName:  req.FirstName,
+		LastName:   req.LastName,
+		Phone:      req.Phone,
+		Line1:      req.Line1,
+		Line2:      req.Line2,
+		City:       req.City,
+		Region:     req.Region,
+		PostalCode: req.PostalCode,
+		Country:    strings.ToUpper(req.Country),
+	}
+}
+
+// resolveAddresses loads the addresses chosen for checkout, falling back to the
+// user's defaults. Billing falls back to the shipping address when there is no default.
+func (s *OrderService) resolveAddresses(userID uint, req *dto.CreateOrderRequest) (*models.Address, *models.Address, error) {
+	var (
+		shippingAddress *models.Address
+		billingAddress  *models.Address
+		err             error
+	)
+
+	if req.ShippingAddressID != nil {
+		shippingAddress, err = s.addressRepo.GetByUserIDAndID(userID, *req.ShippingAddressID)
+	} else {
+		shippingAddress, err = s.addressRepo.GetDefaultShipping(userID)
+	}
+	if err != nil {
+		return nil, nil, errors.New("shipping address not found")
+	}
+
+	if req.BillingAddressID != nil {
+		billingAddress, err = s.addressRepo.GetByUserIDAndID(userID, *req.BillingAddressID)
+		if err != nil {
+			return nil, nil, errors.New("billing address not found")
+		}
+	} else if billingAddress, err = s.addressRepo.GetDefaultBilling(userID); err != nil {
+		billingAddress = shippingAddress
+	}
+
+	return shippingAddress, billingAddress, nil
 }
 
 func (s *OrderService) GetOrders(userID uint, page, limit int) ([]dto.OrderResponse, *utils.PaginationMeta, error) {
@@ -87,42 +290,414 @@ func (s *OrderService) GetOrder(userID, orderID uint) (*dto.OrderResponse, error
 	return &response, nil
 }
 
+// GetAllOrders lists the orders of all users that match filter, with the
+// customer who placed each one.
+func (s *OrderService) GetAllOrders(filter *dto.AdminOrderFilter, page, limit int) ([]dto.OrderResponse, *utils.PaginationMeta, error) {
+	orderFilter, err := toOrderFilter(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	offset := (page - 1) * limit
+
+	total, err := s.orderRepo.GetAllOrdersCount(orderFilter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orders, err := s.orderRepo.GetAllOrders(orderFilter, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := make([]dto.OrderResponse, len(orders))
+	for i := range orders {
+		response[i] = s.convertToOrderResponse(&orders[i])
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	meta := &utils.PaginationMeta{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return response, meta, nil
+}
+
+// GetOrderByID returns any user's order with the customer who placed it.
+func (s *OrderService) GetOrderByID(orderID uint) (*dto.OrderResponse, error) {
+	order, err := s.orderRepo.GetOrderByID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.convertToOrderResponse(order)
+	return &response, nil
+}
+
+// toOrderFilter checks an admin order filter and converts it for the
+// repository. GraphQL requests skip the REST binding rules, so the values are
+// checked here.
+func toOrderFilter(filter *dto.AdminOrderFilter) (repositories.OrderFilter, error) {
+	orderFilter := repositories.OrderFilter{}
+	if filter == nil {
+		return orderFilter, nil
+	}
+
+	switch status := models.OrderStatus(filter.Status); status {
+	case "", models.OrderStatusPending, models.OrderStatusConfirmed, models.OrderStatusShipped,
+		models.OrderStatusDelivered, models.OrderStatusCancelled:
+		orderFilter.Status = status
+	default:
+		return orderFilter, fmt.Errorf("invalid order status: %s", filter.Status)
+	}
+
+	switch filter.SortBy {
+	case "", "created_at":
+		orderFilter.SortBy = "created_at"
+	case "total":
+		orderFilter.SortBy = "total_amount"
+	default:
+		return orderFilter, fmt.Errorf("invalid sort field: %s", filter.SortBy)
+	}
+
+	switch filter.SortOrder {
+	case "", "desc":
+	case "asc":
+		orderFilter.Ascending = true
+	default:
+		return orderFilter, fmt.Errorf("invalid sort order: %s", filter.SortOrder)
+	}
+
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedTo.After(*filter.CreatedFrom) {
+		return orderFilter, errors.New("created_to must be after created_from")
+	}
+
+	orderFilter.CreatedFrom = filter.CreatedFrom
+	orderFilter.CreatedTo = filter.CreatedTo
+	orderFilter.UserEmail = strings.TrimSpace(filter.UserEmail)
+	orderFilter.MinTotal = filter.MinTotal
+	return orderFilter, nil
+}
+
+func (s *OrderService) UpdateOrderStatus(orderID uint, req *dto.UpdateOrderStatusRequest) (*dto.OrderResponse, error) {
+	status := models.OrderStatus(req.Status)
+
+	// Shipping goes through CreateShipment, which captures the payment and
+	// records what was sent, and delivery through the shipments arriving
+	if status == models.OrderStatusShipped || status == models.OrderStatusDelivered {
+		return nil, fmt.Errorf("orders become %s through their shipments", status)
+	}
+
+	order, previousStatus, err := s.orderRepo.UpdateOrderStatus(orderID, status, "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishStatusChanged(order, previousStatus)
+	if order.Status == models.OrderStatusCancelled {
+		s.releasePayment(order.ID)
+	}
+
+	response := s.convertToOrderResponse(order)
+	return &response, nil
+}
+
+// CreateShipment ships items of a confirmed order in one parcel. The order
+// moves to shipped once all of its items have shipped.
+func (s *OrderService) CreateShipment(orderID uint, req *dto.CreateShipmentRequest) (*dto.OrderResponse, error) {
+	shipment := models.Shipment{
+		Carrier:        strings.TrimSpace(req.Carrier),
+		TrackingNumber: strings.TrimSpace(req.TrackingNumber),
+	}
+	for _, item := range req.Items {
+		if item.Quantity < 1 {
+			return nil, errors.New("shipment quantities must be at least 1")
+		}
+		shipment.Items = append(shipment.Items, models.ShipmentItem{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	// Only confirmed orders ship, which is checked again when the shipment is
+	// written, but checking first keeps other orders away from the provider
+	order, err := s.orderRepo.GetOrderByID(orderID)
+	if err != nil {
+		return nil, ErrOrderNotFound
+	}
+	if order.Status != models.OrderStatusConfirmed {
+		return nil, fmt.Errorf("cannot ship a %s order", order.Status)
+	}
+
+	// Take the money before the first parcel leaves. Capturing an already
+	// captured payment is a no-op, so later parcels pass straight through
+	if err := s.paymentService.Capture(orderID); err != nil {
+		return nil, fmt.Errorf("failed to capture payment: %w", err)
+	}
+
+	order, previousStatus, err := s.orderRepo.CreateShipment(orderID, &shipment)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousStatus != "" {
+		s.publishStatusChanged(order, previousStatus)
+	}
+
+	response := s.convertToOrderResponse(order)
+	return &response, nil
+}
+
+// MarkShipmentDelivered records that a parcel arrived. The order moves to
+// delivered once all of its shipments have.
+func (s *OrderService) MarkShipmentDelivered(shipmentID uint) (*dto.OrderResponse, error) {
+	order, previousStatus, err := s.orderRepo.MarkShipmentDelivered(shipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousStatus != "" {
+		s.publishStatusChanged(order, previousStatus)
+	}
+
+	response := s.convertToOrderResponse(order)
+	return &response, nil
+}
+
+func (s *OrderService) CancelOrder(userID, orderID uint, req *dto.CancelOrderRequest) (*dto.OrderResponse, error) {
+
+	order, previousStatus, err := s.orderRepo.CancelOrder(userID, orderID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishStatusChanged(order, previousStatus)
+	s.releasePayment(order.ID)
+
+	response := s.convertToOrderResponse(order)
+	return &response, nil
+}
+
+// HandlePaymentWebhook applies a payment provider notification and moves an
+// order that was waiting on its payment to confirmed or cancelled.
+func (s *OrderService) HandlePaymentWebhook(payload []byte, signature string) error {
+	payment, previousStatus, err := s.paymentService.HandleWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	if previousStatus != models.PaymentStatusPending || payment.Status == previousStatus {
+		return nil
+	}
+
+	switch payment.Status {
+	case models.PaymentStatusAuthorized, models.PaymentStatusCaptured:
+		// Some providers capture on authorization, which pays for the order just the same
+		order, orderPreviousStatus, err := s.orderRepo.UpdateOrderStatus(payment.OrderID, models.OrderStatusConfirmed, "")
+		if err != nil {
+			// The order was cancelled while the payment was pending, so don't hold the funds
+			log.Printf("unable to confirm order %d after payment: %v", payment.OrderID, err)
+			return s.paymentService.Release(payment.OrderID)
+		}
+		s.publishStatusChanged(order, orderPreviousStatus)
+	case models.PaymentStatusFailed:
+		// The guest cart of a guest order isn't known here, so only user
+		// orders get their items back in the cart
+		s.cancelUnpaidOrder(payment.OrderID, nil, "payment failed: "+payment.FailureReason)
+	}
+
+	return nil
+}
+
+// cancelUnpaidOrder cancels an order whose payment did not go through, which
+// also puts its stock back and its items back in the shopper's cart.
+func (s *OrderService) cancelUnpaidOrder(orderID uint, guestCartID *uint, reason string) {
+	order, previousStatus, err := s.orderRepo.CancelUnpaidOrder(orderID, guestCartID, reason)
+	if err != nil {
+		log.Printf("unable to cancel unpaid order %d: %v", orderID, err)
+		return
+	}
+
+	s.publishStatusChanged(order, previousStatus)
+}
+
+// releasePayment voids or refunds the payment of a cancelled order. The
+// cancellation is already committed, so a provider failure is logged for
+// follow-up rather than returned to the caller.
+func (s *OrderService) releasePayment(orderID uint) {
+	if err := s.paymentService.Release(orderID); err != nil {
+		log.Printf("unable to release payment for order %d: %v", orderID, err)
+	}
+}
+
+// publishStatusChanged emits the status transition event. The transition is
+// already committed at this point, so a publish failure is logged rather than
+// returned to the caller.
+func (s *OrderService) publishStatusChanged(order *models.Order, previousStatus models.OrderStatus) {
+	event := dto.OrderStatusChangedEvent{
+		OrderID:        order.ID,
+		UserID:         order.UserID,
+		GuestEmail:     order.GuestEmail,
+		PreviousStatus: string(previousStatus),
+		Status:         string(order.Status),
+		ChangedAt:      order.UpdatedAt,
+	}
+
+	if err := s.eventPublisher.Publish(notifications.OrderStatusChanged, event, map[string]string{}); err != nil {
+		log.Printf("unable to publish order status event for order %d: %v", order.ID, err)
+	}
+}
+
 func (s *OrderService) convertToOrderResponse(order *models.Order) dto.OrderResponse {
 	orderItems := make([]dto.OrderItemResponse, len(order.OrderItems))
 	for i := range order.OrderItems {
 		item := order.OrderItems[i]
 
+		// The product ID is kept even when the product has since been deleted
+		product := convertToProductResponse(&item.Product)
+		product.ID = item.ProductID
+
 		orderItems[i] = dto.OrderItemResponse{
-			ID: item.ID,
-			Product: dto.ProductResponse{
-				ID:          item.Product.ID,
-				CategoryID:  item.Product.CategoryID,
-				Name:        item.Product.Name,
-				Description: item.Product.Description,
-				Price:       item.Product.Price,
-				Stock:       item.Product.Stock,
-				SKU:         item.Product.SKU,
-				IsActive:    item.Product.IsActive,
-				Category: dto.CategoryResponse{
-					ID:          item.Product.Category.ID,
-					Name:        item.Product.Category.Name,
-					Description: item.Product.Category.Description,
-					IsActive:    item.Product.Category.IsActive,
-				},
-			},
-			Quantity:  item.Quantity,
-			Price:     item.Price,
-			CreatedAt: item.CreatedAt,
+			ID:          item.ID,
+			ProductID:   item.ProductID,
+			VariantID:   item.VariantID,
+			ProductName: item.ProductName,
+			VariantName: item.VariantName,
+			SKU:         item.ProductSKU,
+			UnitPrice:   item.UnitPrice,
+			LineTotal:   item.LineTotal,
+			ImageURL:    item.ImageURL,
+
+			DiscountAmount:     item.DiscountAmount,
+			TaxAmount:          item.TaxAmount,
+			TaxRateBasisPoints: item.TaxRateBasisPoints,
+			Product:            product,
+			Quantity:           item.Quantity,
+			Price:              item.Price,
+			CreatedAt:          item.CreatedAt,
 		}
 	}
 
+	var customer *dto.OrderCustomerResponse
+	if order.User.ID != 0 {
+		customer = &dto.OrderCustomerResponse{
+			ID:        order.User.ID,
+			Email:     order.User.Email,
+			FirstName: order.User.FirstName,
+			LastName:  order.User.LastName,
+			Phone:     order.User.Phone,
+		}
+	}
+
+	payments := make([]dto.PaymentResponse, len(order.Payments))
+	for i := range order.Payments {
+		payment := order.Payments[i]
+
+		payments[i] = dto.PaymentResponse{
+			ID:            payment.ID,
+			Provider:      payment.Provider,
+			TransactionID: payment.TransactionID,
+			Status:        string(payment.Status),
+			Amount:        payment.Amount,
+			FailureReason: payment.FailureReason,
+			CreatedAt:     payment.CreatedAt,
+			UpdatedAt:     payment.UpdatedAt,
+		}
+	}
+
+	shipments := make([]dto.ShipmentResponse, len(order.Shipments))
+	for i := range order.Shipments {
+		shipment := order.Shipments[i]
+
+		items := make([]dto.ShipmentItemResponse, len(shipment.Items))
+		for j := range shipment.Items {
+			items[j] = dto.ShipmentItemResponse{
+				ID:          shipment.Items[j].ID,
+				OrderItemID: shipment.Items[j].OrderItemID,
+				Quantity:    shipment.Items[j].Quantity,
+			}
+			for k := range order.OrderItems {
+				if order.OrderItems[k].ID == shipment.Items[j].OrderItemID {
+					items[j].ProductName = order.OrderItems[k].DisplayName()
+					items[j].SKU = order.OrderItems[k].ProductSKU
+					break
+				}
+			}
+		}
+
+		shipments[i] = dto.ShipmentResponse{
+			ID:             shipment.ID,
+			Carrier:        shipment.Carrier,
+			TrackingNumber: shipment.TrackingNumber,
+			Status:         string(shipment.Status),
+			Items:          items,
+			ShippedAt:      shipment.ShippedAt,
+			DeliveredAt:    shipment.DeliveredAt,
+		}
+	}
+
+	invoiceNumber := ""
+	if order.Invoice != nil {
+		invoiceNumber = order.Invoice.Number
+	}
+
 	return dto.OrderResponse{
-		ID:          order.ID,
-		UserID:      order.UserID,
-		Status:      string(order.Status),
-		TotalAmount: order.TotalAmount,
-		OrderItems:  orderItems,
-		CreatedAt:   order.CreatedAt,
-		UpdatedAt:   order.UpdatedAt,
+		ID:                 order.ID,
+		UserID:             order.UserID,
+		GuestEmail:         order.GuestEmail,
+		Customer:           customer,
+		Status:             string(order.Status),
+		SubtotalAmount:     order.SubtotalAmount,
+		DiscountAmount:     order.DiscountAmount,
+		CouponCode:         order.CouponCode,
+		ShippingMethodID:   order.ShippingMethodID,
+		ShippingMethodName: order.ShippingMethodName,
+		ShippingAmount:     order.ShippingAmount,
+		TaxAmount:          order.TaxAmount,
+		TotalAmount:        order.TotalAmount,
+		RefundedAmount:     order.RefundedAmount,
+		PricesIncludeTax:   order.PricesIncludeTax,
+		OrderItems:         orderItems,
+		ConfirmedAt:        order.ConfirmedAt,
+		ShippedAt:          order.ShippedAt,
+		DeliveredAt:        order.DeliveredAt,
+		CancelledAt:        order.CancelledAt,
+		CancellationReason: order.CancellationReason,
+		ShippingAddress:    convertToOrderAddressResponse(&order.ShippingAddress),
+		BillingAddress:     convertToOrderAddressResponse(&order.BillingAddress),
+		Payments:           payments,
+		Shipments:          shipments,
+		InvoiceNumber:      invoiceNumber,
+		CreatedAt:          order.CreatedAt,
+		UpdatedAt:          order.UpdatedAt,
+	}
+}
+
+func convertToOrderAddressResponse(address *models.OrderAddress) dto.OrderAddressResponse {
+	return dto.OrderAddressResponse{
+		FirstName:  address.FirstName,
+		LastName:   address.LastName,
+		Phone:      address.Phone,
+		Line1:      address.Line1,
+		Line2:      address.Line2,
+		City:       address.City,
+		Region:     address.Region,
+		PostalCode: address.PostalCode,
+		Country:    address.Country,
 	}
 }
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+// stubOrderRepository records status changes. Calling any other method panics.
+type stubOrderRepository struct {
+	repositories.OrderRepositoryInterface
+	statuses map[uint]models.OrderStatus
+}
+
+func (r *stubOrderRepository) UpdateOrderStatus(orderID uint, status models.OrderStatus, reason string) (*models.Order, models.OrderStatus, error) {
+	previousStatus := r.statuses[orderID]
+	r.statuses[orderID] = status
+	return &models.Order{ID: orderID, Status: status}, previousStatus, nil
+}
+
+// stubPaymentService reports a webhook that moved payment on from
+// previousStatus. Calling any other method panics.
+type stubPaymentService struct {
+	PaymentServiceInterface
+	payment        models.Payment
+	previousStatus models.PaymentStatus
+}
+
+func (s *stubPaymentService) HandleWebhook(payload []byte, signature string) (*models.Payment, models.PaymentStatus, error) {
+	return &s.payment, s.previousStatus, nil
+}
+
+type stubPublisher struct{}
+
+func (stubPublisher) Publish(eventType string, payload interface{}, metadata map[string]string) error {
+	return nil
+}
+
+func (stubPublisher) Close() error {
+	return nil
+}
+
+func TestHandlePaymentWebhookConfirmsOrder(t *testing.T) {
+	for _, status := range []models.PaymentStatus{models.PaymentStatusAuthorized, models.PaymentStatusCaptured} {
+		t.Run(string(status), func(t *testing.T) {
+			orderRepo := &stubOrderRepository{statuses: map[uint]models.OrderStatus{1: models.OrderStatusPending}}
+			paymentService := &stubPaymentService{
+				payment:        models.Payment{OrderID: 1, Status: status},
+				previousStatus: models.PaymentStatusPending,
+			}
+			service := NewOrderService(orderRepo, nil, nil, nil, paymentService, stubPublisher{})
+
+			if err := service.HandlePaymentWebhook(nil, ""); err != nil {
+				t.Fatalf("HandlePaymentWebhook: %v", err)
+			}
+			if orderRepo.statuses[1] != models.OrderStatusConfirmed {
+				t.Errorf("order is %s, want %s", orderRepo.statuses[1], models.OrderStatusConfirmed)
+			}
+		})
+	}
+}
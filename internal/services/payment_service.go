@@ -0,0 +1,172 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/interfaces"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+var _ PaymentServiceInterface = (*PaymentService)(nil)
+
+type PaymentService struct {
+	paymentRepo repositories.PaymentRepositoryInterface
+	provider    interfaces.PaymentProvider
+}
+
+func NewPaymentService(paymentRepo repositories.PaymentRepositoryInterface, provider interfaces.PaymentProvider) *PaymentService {
+	return &PaymentService{
+		paymentRepo: paymentRepo,
+		provider:    provider,
+	}
+}
+
+// Authorize asks the provider to authorize the order total and records the
+// outcome. A declined payment is returned with PaymentStatusFailed.
+func (s *PaymentService) Authorize(order *models.Order, token string) (*models.Payment, error) {
+	result, err := s.provider.Authorize(interfaces.PaymentRequest{
+		OrderID: order.ID,
+		Amount:  order.TotalAmount,
+		Token:   token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment := models.Payment{
+		OrderID:       order.ID,
+		Provider:      s.provider.Name(),
+		TransactionID: result.TransactionID,
+		Status:        result.Status,
+		Amount:        order.TotalAmount,
+		FailureReason: result.FailureReason,
+	}
+	if err := s.paymentRepo.Create(&payment); err != nil {
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+// Capture collects the authorized payment of an order. An already captured
+// payment, or an order without a payment, such as one placed before payments
+// existed, is left alone. Any other payment that doesn't end up captured is
+// an error, so the order isn't shipped unpaid.
+func (s *PaymentService) Capture(orderID uint) error {
+	payment, err := s.paymentRepo.GetLatestByOrderID(orderID)
+	if err != nil {
+		return err
+	}
+	if payment == nil || payment.Status == models.PaymentStatusCaptured {
+		return nil
+	}
+	if payment.Status != models.PaymentStatusAuthorized {
+		return fmt.Errorf("cannot capture a %s payment", payment.Status)
+	}
+
+	result, err := s.provider.Capture(payment.TransactionID, payment.Amount)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.paymentRepo.UpdateStatus(payment, result.Status, result.FailureReason); err != nil {
+		return err
+	}
+	if result.Status != models.PaymentStatusCaptured {
+		return fmt.Errorf("capture %s: %s", result.Status, result.FailureReason)
+	}
+	return nil
+}
+
+// Release gives the money of a cancelled order back: an uncaptured payment is
+// voided and a captured one is refunded in full.
+func (s *PaymentService) Release(orderID uint) error {
+	payment, err := s.paymentRepo.GetLatestByOrderID(orderID)
+	if err != nil || payment == nil {
+		return err
+	}
+
+	var result *interfaces.PaymentResult
+	switch payment.Status {
+	case models.PaymentStatusPending, models.PaymentStatusAuthorized:
+		result, err = s.provider.Void(payment.TransactionID)
+	case models.PaymentStatusCaptured:
+		result, err = s.provider.Refund(payment.TransactionID, payment.Amount)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = s.paymentRepo.UpdateStatus(payment, result.Status, result.FailureReason)
+	return err
+}
+
+// Refund gives part of an order's captured payment back, such as the amount
+// for returned items. refundedTotal is everything refunded on the order
+// including this refund; once it covers the payment, the payment is marked
+// refunded. Orders without a payment are left alone.
+func (s *PaymentService) Refund(orderID uint, amount, refundedTotal money.Money) error {
+	payment, err := s.paymentRepo.GetLatestByOrderID(orderID)
+	if err != nil || payment == nil {
+		return err
+	}
+	if payment.Status != models.PaymentStatusCaptured {
+		return fmt.Errorf("cannot refund a %s payment", payment.Status)
+	}
+
+	result, err := s.provider.Refund(payment.TransactionID, amount)
+	if err != nil {
+		return err
+	}
+	if result.Status == models.PaymentStatusFailed {
+		return fmt.Errorf("refund declined: %s", result.FailureReason)
+	}
+
+	if refundedTotal.Cmp(payment.Amount) < 0 {
+		return nil
+	}
+
+	_, err = s.paymentRepo.UpdateStatus(payment, models.PaymentStatusRefunded, "")
+	return err
+}
+
+// HandleWebhook applies an asynchronous provider notification to the matching
+// payment. It returns the payment and the status it had before; when the
+// notification changes nothing, both statuses are equal. A notification that
+// would move the payment backwards, such as a captured payment to authorized,
+// is rejected.
+func (s *PaymentService) HandleWebhook(payload []byte, signature string) (*models.Payment, models.PaymentStatus, error) {
+	result, err := s.provider.ParseWebhook(payload, signature)
+	if err != nil {
+		return nil, "", err
+	}
+
+	payment, err := s.paymentRepo.GetByTransactionID(s.provider.Name(), result.TransactionID)
+	if err != nil {
+		return nil, "", errors.New("payment not found")
+	}
+
+	previousStatus := payment.Status
+	if payment.Status == result.Status {
+		return payment, previousStatus, nil
+	}
+	if !payment.Status.CanTransitionTo(result.Status) {
+		return nil, "", fmt.Errorf("cannot move a %s payment to %s", payment.Status, result.Status)
+	}
+
+	updated, err := s.paymentRepo.UpdateStatus(payment, result.Status, result.FailureReason)
+	if err != nil {
+		return nil, "", err
+	}
+	if !updated {
+		// Another notification changed the payment first
+		return payment, payment.Status, nil
+	}
+
+	return payment, previousStatus, nil
+}
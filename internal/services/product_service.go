@@ -1,16 +1,41 @@
 package services
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
+	"unicode"
 
 	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/tax"
 	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
 )
 
 var _ ProductServiceInterface = (*ProductService)(nil)
 
+var (
+	errInvalidPrice    = errors.New("price must be greater than zero")
+	errVariantNotFound = errors.New("variant not found")
+)
+
+// Category errors caused by the request rather than by the server.
+var (
+	ErrCategoryNotFound       = errors.New("category not found")
+	ErrParentCategoryNotFound = errors.New("parent category not found")
+	ErrCategoryCycle          = errors.New("a category can't be moved under itself or one of its subcategories")
+	ErrInvalidCategorySlug    = errors.New("slug may only contain lowercase letters, digits and single hyphens")
+	ErrCategorySlugTaken      = errors.New("slug already in use")
+	ErrCategoryHasChildren    = errors.New("category has subcategories")
+)
+
+// productSorts are the sort keys of product listings. Searches can also be
+// sorted by relevance, which is their default.
+var productSorts = []string{"newest", "price_asc", "price_desc", "popularity"}
+
 type ProductService struct {
 	productRepo repositories.ProductRepositoryInterface
 }
@@ -20,21 +45,25 @@ func NewProductService(productRepo repositories.ProductRepositoryInterface) *Pro
 }
 
 func (s *ProductService) CreateCategory(req *dto.CreateCategoryRequest) (*dto.CategoryResponse, error) {
+	if req.ParentID != nil {
+		if _, err := s.productRepo.GetCategoriesByID(*req.ParentID); err != nil {
+			return nil, ErrParentCategoryNotFound
+		}
+	}
 
-	category, err := s.productRepo.CreateCategory(req.Name, req.Description)
+	slug, err := s.categorySlug(0, req.Name, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.productRepo.CreateCategory(req.ParentID, req.Name, slug, req.Description)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.CategoryResponse{
-		ID:          category.ID,
-		Name:        category.Name,
-		Description: category.Description,
-		IsActive:    category.IsActive,
-		CreatedAt:   category.CreatedAt,
-		UpdatedAt:   category.UpdatedAt,
-	}, nil
+	response := convertToCategoryResponse(category)
+	return &response, nil
 
 }
 
@@ -47,53 +76,173 @@ func (s *ProductService) GetCategories() ([]dto.CategoryResponse, error) {
 
 	response := make([]dto.CategoryResponse, len(categories))
 	for i := range categories {
-		response[i] = dto.CategoryResponse{
-			ID:          categories[i].ID,
-			Name:        categories[i].Name,
-			Description: categories[i].Description,
-			IsActive:    categories[i].IsActive,
-			CreatedAt:   categories[i].CreatedAt,
-			UpdatedAt:   categories[i].UpdatedAt,
+		response[i] = convertToCategoryResponse(&categories[i])
+	}
+
+	return response, nil
+}
+
+// GetCategoryTree returns the active categories as a tree of top-level
+// categories. Categories under an inactive category are left out with it.
+func (s *ProductService) GetCategoryTree() ([]dto.CategoryTreeResponse, error) {
+	categories, err := s.productRepo.GetCategoriesByStatus(true)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[uint][]*models.Category)
+	var roots []*models.Category
+	for i := range categories {
+		if categories[i].ParentID == nil {
+			roots = append(roots, &categories[i])
+		} else {
+			children[*categories[i].ParentID] = append(children[*categories[i].ParentID], &categories[i])
 		}
 	}
 
+	var build func(categories []*models.Category) []dto.CategoryTreeResponse
+	build = func(categories []*models.Category) []dto.CategoryTreeResponse {
+		tree := make([]dto.CategoryTreeResponse, len(categories))
+		for i, category := range categories {
+			tree[i] = dto.CategoryTreeResponse{
+				CategoryResponse: convertToCategoryResponse(category),
+				Children:         build(children[category.ID]),
+			}
+		}
+		return tree
+	}
+
+	return build(roots), nil
+}
+
+// GetChildCategories returns the active categories directly under a category.
+func (s *ProductService) GetChildCategories(id uint) ([]dto.CategoryResponse, error) {
+	categories, err := s.productRepo.GetChildCategoriesByStatus(id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.CategoryResponse, len(categories))
+	for i := range categories {
+		response[i] = convertToCategoryResponse(&categories[i])
+	}
+
 	return response, nil
 }
 
+// GetCategoryBreadcrumbs returns the path from the top-level category down
+// to the category, ending with the category itself.
+func (s *ProductService) GetCategoryBreadcrumbs(id uint) ([]dto.CategoryBreadcrumbResponse, error) {
+	path, err := s.productRepo.GetCategoryPath(id)
+	if err != nil {
+		return nil, ErrCategoryNotFound
+	}
+
+	breadcrumbs := make([]dto.CategoryBreadcrumbResponse, len(path))
+	for i := range path {
+		breadcrumbs[i] = dto.CategoryBreadcrumbResponse{
+			ID:   path[i].ID,
+			Name: path[i].Name,
+			Slug: path[i].Slug,
+		}
+	}
+
+	return breadcrumbs, nil
+}
+
+// UpdateCategory replaces a category's details, moving it under another
+// parent when that changes. A category can't be moved under itself or one
+// of the categories under it.
 func (s *ProductService) UpdateCategory(id uint, req *dto.UpdateCategoryRequest) (*dto.CategoryResponse, error) {
 
 	category, err := s.productRepo.GetCategoriesByID(id)
 	if err != nil {
-		return nil, err
+		return nil, ErrCategoryNotFound
+	}
+
+	if req.Slug != "" && req.Slug != category.Slug {
+		if category.Slug, err = s.categorySlug(category.ID, req.Name, req.Slug); err != nil {
+			return nil, err
+		}
 	}
 
+	category.ParentID = req.ParentID
 	category.Name = req.Name
 	category.Description = req.Description
 	if req.IsActive != nil {
 		category.IsActive = *req.IsActive
 	}
 
-	if err := s.productRepo.UpdateCategory(category); err != nil {
+	// The new parent is checked as the category is saved, so a concurrent
+	// move can't slip a cycle in between
+	err = s.productRepo.UpdateCategory(category, func(path []models.Category) error {
+		if path == nil {
+			return ErrParentCategoryNotFound
+		}
+		for i := range path {
+			if path[i].ID == category.ID {
+				return ErrCategoryCycle
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &dto.CategoryResponse{
-		ID:          category.ID,
-		Name:        category.Name,
-		Description: category.Description,
-		IsActive:    category.IsActive,
-		CreatedAt:   category.CreatedAt,
-		UpdatedAt:   category.UpdatedAt,
-	}, nil
+	response := convertToCategoryResponse(category)
+	return &response, nil
 }
 
+// DeleteCategory deletes a category. Categories with subcategories can't be
+// deleted until those are moved or deleted.
 func (s *ProductService) DeleteCategory(id uint) error {
+	count, err := s.productRepo.GetChildCategoryCount(id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCategoryHasChildren
+	}
+
 	return s.productRepo.DeleteCategory(id)
 }
 
+// categorySlug returns the slug for the category with id, or for a new one
+// when id is 0. A requested slug must be free. Without one, the slug is made
+// from the name, with a number added when another category has it.
+func (s *ProductService) categorySlug(id uint, name, requested string) (string, error) {
+	if requested != "" {
+		if !utils.IsSlug(requested) {
+			return "", ErrInvalidCategorySlug
+		}
+		if existing, err := s.productRepo.GetCategoryBySlug(requested); err == nil && existing.ID != id {
+			return "", ErrCategorySlugTaken
+		}
+		return requested, nil
+	}
+
+	base := utils.Slugify(name)
+	if base == "" {
+		base = "category"
+	}
+
+	slug := base
+	for n := 2; ; n++ {
+		existing, err := s.productRepo.GetCategoryBySlug(slug)
+		if err != nil || existing.ID == id {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, n)
+	}
+}
+
 func (s *ProductService) CreateProduct(req *dto.CreateProductRequest) (*dto.ProductResponse, error) {
+	if !req.Price.IsPositive() {
+		return nil, errInvalidPrice
+	}
 
-	product, err := s.productRepo.CreateProduct(req.CategoryID, req.Name, req.Description, req.Price, req.Stock, req.SKU)
+	product, err := s.productRepo.CreateProduct(req.CategoryID, req.Name, req.Description, req.Price, req.Stock, req.SKU, taxClassOrDefault(req.TaxClass), req.WeightGrams)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +250,20 @@ func (s *ProductService) CreateProduct(req *dto.CreateProductRequest) (*dto.Prod
 	return s.GetProduct(product.ID)
 }
 
-func (s *ProductService) GetProducts(page, limit int) ([]dto.ProductResponse, *utils.PaginationMeta, error) {
+// GetProducts lists active products, narrowed down by filter when it is set.
+func (s *ProductService) GetProducts(filter *dto.ProductFilter, page, limit int) ([]dto.ProductResponse, *utils.PaginationMeta, error) {
+	if filter == nil {
+		filter = &dto.ProductFilter{}
+	}
+	if filter.Sort != "" && !slices.Contains(productSorts, filter.Sort) {
+		return nil, nil, fmt.Errorf("invalid sort: %s", filter.Sort)
+	}
+	productFilter := repositories.ProductFilter{
+		CategoryID:           filter.CategoryID,
+		IncludeSubcategories: filter.IncludeSubcategories,
+		Sort:                 filter.Sort,
+	}
+
 	if page < 1 {
 		page = 1
 	}
@@ -112,19 +274,19 @@ func (s *ProductService) GetProducts(page, limit int) ([]dto.ProductResponse, *u
 
 	offset := (page - 1) * limit
 
-	total, err := s.productRepo.GetProductsCountByStatus(true)
+	total, err := s.productRepo.GetProductsCountByStatus(true, productFilter)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	products, err := s.productRepo.GetProductsByStatus(true, offset, limit)
+	products, err := s.productRepo.GetProductsByStatus(true, productFilter, offset, limit)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	response := make([]dto.ProductResponse, len(products))
 	for i := range products {
-		response[i] = s.convertToProductResponse(&products[i])
+		response[i] = convertToProductResponse(&products[i])
 	}
 
 	totalPages := int((total + int64(limit) - 1) / int64(limit))
@@ -145,11 +307,14 @@ func (s *ProductService) GetProduct(id uint) (*dto.ProductResponse, error) {
 		return nil, err
 	}
 
-	response := s.convertToProductResponse(product)
+	response := convertToProductResponse(product)
 	return &response, nil
 }
 
 func (s *ProductService) UpdateProduct(id uint, req *dto.UpdateProductRequest) (*dto.ProductResponse, error) {
+	if !req.Price.IsPositive() {
+		return nil, errInvalidPrice
+	}
 
 	product, err := s.productRepo.GetProductByID(id)
 	if err != nil {
@@ -161,6 +326,8 @@ func (s *ProductService) UpdateProduct(id uint, req *dto.UpdateProductRequest) (
 	product.Description = req.Description
 	product.Price = req.Price
 	product.Stock = req.Stock
+	product.TaxClass = taxClassOrDefault(req.TaxClass)
+	product.WeightGrams = req.WeightGrams
 	if req.IsActive != nil {
 		product.IsActive = *req.IsActive
 	}
@@ -176,7 +343,14 @@ func (s *ProductService) DeleteProduct(id uint) error {
 	return s.productRepo.DeleteProduct(id)
 }
 
-func (s *ProductService) AddProductImage(productID uint, url, altText string) error {
+// AddProductImage records an uploaded image of the product, or of one of its
+// variants when variantID is set. The product's first image is its primary one.
+func (s *ProductService) AddProductImage(productID uint, variantID *uint, url, altText string) error {
+	if variantID != nil {
+		if _, err := s.productRepo.GetProductVariant(productID, *variantID); err != nil {
+			return errVariantNotFound
+		}
+	}
 
 	count, err := s.productRepo.GetProductImageCount(productID)
 	if err != nil {
@@ -184,10 +358,197 @@ func (s *ProductService) AddProductImage(productID uint, url, altText string) er
 		_ = err
 	}
 
-	return s.productRepo.AddProductImages(productID, url, altText, count == 0)
+	return s.productRepo.AddProductImages(productID, variantID, url, altText, count == 0)
+}
+
+// CreateProductOption adds an option with its values to a product. Options
+// can't be added once the product has variants, as those would have no value
+// for the new option.
+func (s *ProductService) CreateProductOption(productID uint, req *dto.CreateProductOptionRequest) (*dto.ProductResponse, error) {
+	product, err := s.productRepo.GetProductByID(productID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+
+	if product.HasVariants() {
+		return nil, errors.New("options can't be added to a product that has variants")
+	}
+
+	for i := range product.Options {
+		if strings.EqualFold(product.Options[i].Name, req.Name) {
+			return nil, errors.New("option already exists")
+		}
+	}
+
+	option := models.ProductOption{
+		ProductID: productID,
+		Name:      req.Name,
+		Values:    make([]models.ProductOptionValue, len(req.Values)),
+	}
+	for i, value := range req.Values {
+		for j := range i {
+			if strings.EqualFold(req.Values[j], value) {
+				return nil, fmt.Errorf("duplicate option value %q", value)
+			}
+		}
+		option.Values[i] = models.ProductOptionValue{Value: value, Position: i}
+	}
+
+	if err := s.productRepo.CreateProductOption(&option); err != nil {
+		return nil, err
+	}
+
+	return s.GetProduct(productID)
 }
 
-func (s *ProductService) SearchProducts(req *dto.SearchProductsRequest) ([]dto.ProductSearchResult, *utils.PaginationMeta, error) {
+// CreateProductVariant adds a variant of a product. The variant takes one
+// value of each of the product's options, and no two variants can take the
+// same values.
+func (s *ProductService) CreateProductVariant(productID uint, req *dto.CreateProductVariantRequest) (*dto.ProductVariantResponse, error) {
+	if req.Price != nil && !req.Price.IsPositive() {
+		return nil, errInvalidPrice
+	}
+
+	product, err := s.productRepo.GetProductByID(productID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+
+	if len(product.Options) == 0 {
+		return nil, errors.New("product has no options")
+	}
+
+	values, err := variantOptionValues(product, req.OptionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range product.Variants {
+		if sameOptionValues(product.Variants[i].OptionValues, values) {
+			return nil, errors.New("a variant with these options already exists")
+		}
+	}
+
+	variant := models.ProductVariant{
+		ProductID:    productID,
+		SKU:          req.SKU,
+		Price:        req.Price,
+		Stock:        req.Stock,
+		IsActive:     true,
+		OptionValues: values,
+	}
+	if err := s.productRepo.CreateProductVariant(&variant); err != nil {
+		return nil, err
+	}
+
+	return s.getProductVariant(productID, variant.ID)
+}
+
+// UpdateProductVariant changes a variant's price, stock and whether it is
+// sold. Its option values can't change.
+func (s *ProductService) UpdateProductVariant(productID, variantID uint, req *dto.UpdateProductVariantRequest) (*dto.ProductVariantResponse, error) {
+	if req.Price != nil && !req.Price.IsPositive() {
+		return nil, errInvalidPrice
+	}
+
+	variant, err := s.productRepo.GetProductVariant(productID, variantID)
+	if err != nil {
+		return nil, errVariantNotFound
+	}
+
+	variant.Price = req.Price
+	variant.Stock = req.Stock
+	if req.IsActive != nil {
+		variant.IsActive = *req.IsActive
+	}
+
+	if err := s.productRepo.UpdateProductVariant(variant); err != nil {
+		return nil, err
+	}
+
+	return s.getProductVariant(productID, variantID)
+}
+
+func (s *ProductService) DeleteProductVariant(productID, variantID uint) error {
+	if _, err := s.productRepo.GetProductVariant(productID, variantID); err != nil {
+		return errVariantNotFound
+	}
+
+	return s.productRepo.DeleteProductVariant(productID, variantID)
+}
+
+func (s *ProductService) getProductVariant(productID, variantID uint) (*dto.ProductVariantResponse, error) {
+	product, err := s.productRepo.GetProductByID(productID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+
+	variant := product.Variant(variantID)
+	if variant == nil {
+		return nil, errVariantNotFound
+	}
+
+	response := convertToProductVariantResponse(product, variant)
+	return &response, nil
+}
+
+// variantOptionValues looks up the requested option values of a new variant,
+// which must name one value of each of the product's options.
+func variantOptionValues(product *models.Product, requested []dto.VariantOptionValueRequest) ([]models.ProductOptionValue, error) {
+	values := make([]models.ProductOptionValue, 0, len(product.Options))
+	for i := range product.Options {
+		option := &product.Options[i]
+
+		index := slices.IndexFunc(requested, func(r dto.VariantOptionValueRequest) bool {
+			return strings.EqualFold(r.Option, option.Name)
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("a value is needed for option %q", option.Name)
+		}
+
+		valueIndex := slices.IndexFunc(option.Values, func(v models.ProductOptionValue) bool {
+			return strings.EqualFold(v.Value, requested[index].Value)
+		})
+		if valueIndex < 0 {
+			return nil, fmt.Errorf("%q is not a value of option %q", requested[index].Value, option.Name)
+		}
+
+		values = append(values, option.Values[valueIndex])
+	}
+
+	// Every requested option was matched once above, so any others are unknown or repeated
+	if len(requested) != len(values) {
+		return nil, errors.New("a value is needed for each of the product's options, and only those")
+	}
+
+	return values, nil
+}
+
+// sameOptionValues reports whether two variants take the same option values.
+func sameOptionValues(a, b []models.ProductOptionValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !slices.ContainsFunc(b, func(v models.ProductOptionValue) bool { return v.ID == a[i].ID }) {
+			return false
+		}
+	}
+	return true
+}
+
+// SearchProducts returns a page of the products matching the search, with
+// the facets the storefront offers to narrow it down.
+func (s *ProductService) SearchProducts(req *dto.SearchProductsRequest) ([]dto.ProductSearchResult, *dto.SearchFacetsResponse, *utils.PaginationMeta, error) {
+	attributes, err := attributeFilters(req.Attributes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if req.Sort != "" && req.Sort != "relevance" && !slices.Contains(productSorts, req.Sort) {
+		return nil, nil, nil, fmt.Errorf("invalid sort: %s", req.Sort)
+	}
+
 	if req.Page < 1 {
 		req.Page = 1
 	}
@@ -199,63 +560,317 @@ func (s *ProductService) SearchProducts(req *dto.SearchProductsRequest) ([]dto.P
 	offset := (req.Page - 1) * req.Limit
 
 	// build query
-	rows, total, err := s.productRepo.SearchProducts(req.Query, req.CategoryID, req.MaxPrice, req.MaxPrice, offset, req.Limit)
+	search := repositories.ProductSearch{
+		Query: req.Query,
+		ProductFilter: repositories.ProductFilter{
+			CategoryID:           req.CategoryID,
+			IncludeSubcategories: req.IncludeSubcategories,
+			Sort:                 req.Sort,
+		},
+		MinPrice:   req.MinPrice,
+		MaxPrice:   req.MaxPrice,
+		InStock:    req.InStock,
+		Attributes: attributes,
+	}
+	rows, facets, err := s.productRepo.SearchProducts(search, offset, req.Limit)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Build output response
 	results := make([]dto.ProductSearchResult, len(rows))
 	for i := range rows {
 		results[i] = dto.ProductSearchResult{
-			ProductResponse: s.convertToProductResponse(&rows[i].Product),
+			ProductResponse: convertToProductResponse(&rows[i].Product),
 			Rank:            rows[i].Rank,
 		}
 	}
 
 	// build pagination meta
-	totalPages := int((*total + int64(req.Limit) - 1) / int64(req.Limit))
+	totalPages := int((facets.Total + int64(req.Limit) - 1) / int64(req.Limit))
 	meta := &utils.PaginationMeta{
 		Page:       req.Page,
 		Limit:      req.Limit,
-		Total:      *total,
+		Total:      facets.Total,
 		TotalPages: totalPages,
 	}
 
-	return results, meta, nil
+	return results, convertToSearchFacetsResponse(facets), meta, nil
 }
 
-func (s *ProductService) convertToProductResponse(product *models.Product) dto.ProductResponse {
+// attributeFilters groups "option:value" attribute filters by option, in the
+// order the options first appear.
+func attributeFilters(attributes []string) ([]repositories.AttributeFilter, error) {
+	var filters []repositories.AttributeFilter
+	for _, attribute := range attributes {
+		option, value, ok := strings.Cut(attribute, ":")
+		option, value = strings.TrimSpace(option), strings.TrimSpace(value)
+		if !ok || option == "" || value == "" {
+			return nil, fmt.Errorf("attribute filter %q must look like option:value", attribute)
+		}
+
+		index := slices.IndexFunc(filters, func(filter repositories.AttributeFilter) bool {
+			return strings.EqualFold(filter.Option, option)
+		})
+		if index < 0 {
+			filters = append(filters, repositories.AttributeFilter{Option: option})
+			index = len(filters) - 1
+		}
+		filters[index].Values = append(filters[index].Values, value)
+	}
+	return filters, nil
+}
+
+func convertToSearchFacetsResponse(facets *models.ProductFacets) *dto.SearchFacetsResponse {
+	categories := make([]dto.CategoryFacetResponse, len(facets.Categories))
+	for i := range facets.Categories {
+		categories[i] = dto.CategoryFacetResponse{
+			CategoryID: facets.Categories[i].CategoryID,
+			Name:       facets.Categories[i].Name,
+			Count:      facets.Categories[i].Count,
+		}
+	}
+
+	priceRanges := make([]dto.PriceRangeFacetResponse, len(facets.PriceRanges))
+	for i := range facets.PriceRanges {
+		priceRanges[i] = dto.PriceRangeFacetResponse{
+			Min:   facets.PriceRanges[i].Min,
+			Max:   facets.PriceRanges[i].Max,
+			Count: facets.PriceRanges[i].Count,
+		}
+	}
+
+	attributes := make([]dto.AttributeFacetResponse, len(facets.Attributes))
+	for i := range facets.Attributes {
+		attributes[i] = dto.AttributeFacetResponse{
+			Option: facets.Attributes[i].Option,
+			Value:  facets.Attributes[i].Value,
+			Count:  facets.Attributes[i].Count,
+		}
+	}
+
+	return &dto.SearchFacetsResponse{
+		Categories:  categories,
+		PriceRanges: priceRanges,
+		Stock: dto.StockFacetResponse{
+			InStock:    facets.InStock,
+			OutOfStock: facets.OutOfStock,
+		},
+		Attributes: attributes,
+	}
+}
+
+// SuggestSearch completes a partly typed search query with the names of
+// products and categories.
+func (s *ProductService) SuggestSearch(req *dto.SearchSuggestRequest) (*dto.SearchSuggestionsResponse, error) {
+	response := &dto.SearchSuggestionsResponse{
+		Products:   []dto.ProductSuggestionResponse{},
+		Categories: []dto.CategorySuggestionResponse{},
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return response, nil
+	}
+
+	if req.Limit < 1 {
+		req.Limit = 5
+	}
+	req.Limit = min(req.Limit, 10)
+
+	suggestions, err := s.productRepo.SuggestSearch(query, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, suggestion := range suggestions {
+		switch suggestion.Kind {
+		case "product":
+			response.Products = append(response.Products, dto.ProductSuggestionResponse{
+				ID:   suggestion.ID,
+				Name: suggestion.Name,
+			})
+		case "category":
+			response.Categories = append(response.Categories, dto.CategorySuggestionResponse{
+				ID:   suggestion.ID,
+				Name: suggestion.Name,
+				Slug: suggestion.Slug,
+			})
+		}
+	}
+
+	return response, nil
+}
+
+func (s *ProductService) GetSearchSynonyms() ([]dto.SearchSynonymResponse, error) {
+	synonyms, err := s.productRepo.GetSearchSynonyms()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.SearchSynonymResponse, len(synonyms))
+	for i := range synonyms {
+		response[i] = convertToSearchSynonymResponse(&synonyms[i])
+	}
+
+	return response, nil
+}
+
+// CreateSearchSynonym adds a synonym for a term. The term is matched against
+// the lowercased words of search queries, so it is stored lowercased and must
+// be a single word of letters and digits.
+func (s *ProductService) CreateSearchSynonym(req *dto.CreateSearchSynonymRequest) (*dto.SearchSynonymResponse, error) {
+	term := strings.ToLower(strings.TrimSpace(req.Term))
+	if term == "" || strings.IndexFunc(term, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) >= 0 {
+		return nil, errors.New("term must be a single word of letters and digits")
+	}
+
+	synonym := strings.TrimSpace(req.Synonym)
+	if synonym == "" {
+		return nil, errors.New("synonym is required")
+	}
+
+	searchSynonym := models.SearchSynonym{
+		Term:    term,
+		Synonym: synonym,
+	}
+	if err := s.productRepo.CreateSearchSynonym(&searchSynonym); err != nil {
+		return nil, err
+	}
+
+	response := convertToSearchSynonymResponse(&searchSynonym)
+	return &response, nil
+}
+
+func (s *ProductService) DeleteSearchSynonym(id uint) error {
+	return s.productRepo.DeleteSearchSynonym(id)
+}
+
+func convertToSearchSynonymResponse(synonym *models.SearchSynonym) dto.SearchSynonymResponse {
+	return dto.SearchSynonymResponse{
+		ID:        synonym.ID,
+		Term:      synonym.Term,
+		Synonym:   synonym.Synonym,
+		CreatedAt: synonym.CreatedAt,
+		UpdatedAt: synonym.UpdatedAt,
+	}
+}
+
+// convertToProductResponse describes a product wherever it's shown, on its own
+// or in a cart, order or wishlist.
+func convertToProductResponse(product *models.Product) dto.ProductResponse {
 	images := make([]dto.ProductImageResponse, len(product.Images))
 	for i := range product.Images {
-		images[i] = dto.ProductImageResponse{
-			ID:        product.Images[i].ID,
-			URL:       product.Images[i].URL,
-			AltText:   product.Images[i].AltText,
-			IsPrimary: product.Images[i].IsPrimary,
-			CreatedAt: product.Images[i].CreatedAt,
+		images[i] = convertToProductImageResponse(&product.Images[i])
+	}
+
+	options := make([]dto.ProductOptionResponse, len(product.Options))
+	for i := range product.Options {
+		values := make([]string, len(product.Options[i].Values))
+		for j := range product.Options[i].Values {
+			values[j] = product.Options[i].Values[j].Value
+		}
+		options[i] = dto.ProductOptionResponse{
+			ID:     product.Options[i].ID,
+			Name:   product.Options[i].Name,
+			Values: values,
 		}
 	}
 
+	variants := make([]dto.ProductVariantResponse, len(product.Variants))
+	for i := range product.Variants {
+		variants[i] = convertToProductVariantResponse(product, &product.Variants[i])
+	}
+
 	return dto.ProductResponse{
-		ID:          product.ID,
-		CategoryID:  product.CategoryID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Stock:       product.Stock,
-		SKU:         product.SKU,
-		IsActive:    product.IsActive,
-		Category: dto.CategoryResponse{
-			ID:          product.Category.ID,
-			Name:        product.Category.Name,
-			Description: product.Category.Description,
-			IsActive:    product.Category.IsActive,
-			CreatedAt:   product.Category.CreatedAt,
-			UpdatedAt:   product.Category.UpdatedAt,
-		},
-		Images:    images,
-		CreatedAt: product.Category.CreatedAt,
-		UpdatedAt: product.Category.UpdatedAt,
+		ID:             product.ID,
+		CategoryID:     product.CategoryID,
+		Name:           product.Name,
+		Description:    product.Description,
+		Price:          product.Price,
+		Stock:          product.Stock,
+		AvailableStock: product.AvailableStock(),
+		SKU:            product.SKU,
+		TaxClass:       product.TaxClass,
+		WeightGrams:    product.WeightGrams,
+		IsActive:       product.IsActive,
+		Category:       convertToCategoryResponse(&product.Category),
+		Images:         images,
+		Options:        options,
+		Variants:       variants,
+		CreatedAt:      product.CreatedAt,
+		UpdatedAt:      product.UpdatedAt,
+	}
+}
+
+// convertToProductVariantResponse describes a variant of product. The
+// product's options are needed for the variant's name, and its images for
+// the variant's images.
+func convertToProductVariantResponse(product *models.Product, variant *models.ProductVariant) dto.ProductVariantResponse {
+	optionValues := make([]dto.VariantOptionValueResponse, 0, len(variant.OptionValues))
+	for i := range product.Options {
+		for j := range variant.OptionValues {
+			if variant.OptionValues[j].OptionID == product.Options[i].ID {
+				optionValues = append(optionValues, dto.VariantOptionValueResponse{
+					Option: product.Options[i].Name,
+					Value:  variant.OptionValues[j].Value,
+				})
+			}
+		}
+	}
+
+	images := []dto.ProductImageResponse{}
+	for i := range product.Images {
+		if product.Images[i].VariantID != nil && *product.Images[i].VariantID == variant.ID {
+			images = append(images, convertToProductImageResponse(&product.Images[i]))
+		}
+	}
+
+	return dto.ProductVariantResponse{
+		ID:             variant.ID,
+		SKU:            variant.SKU,
+		Name:           product.VariantName(variant),
+		Price:          variant.PriceOr(product.Price),
+		Stock:          variant.Stock,
+		AvailableStock: variant.AvailableStock(),
+		IsActive:       variant.IsActive,
+		OptionValues:   optionValues,
+		Images:         images,
+		CreatedAt:      variant.CreatedAt,
+		UpdatedAt:      variant.UpdatedAt,
+	}
+}
+
+func convertToCategoryResponse(category *models.Category) dto.CategoryResponse {
+	return dto.CategoryResponse{
+		ID:          category.ID,
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		IsActive:    category.IsActive,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
+
+func convertToProductImageResponse(image *models.ProductImage) dto.ProductImageResponse {
+	return dto.ProductImageResponse{
+		ID:        image.ID,
+		VariantID: image.VariantID,
+		URL:       image.URL,
+		AltText:   image.AltText,
+		IsPrimary: image.IsPrimary,
+		CreatedAt: image.CreatedAt,
+	}
+}
+
+// taxClassOrDefault puts products without a tax class in the standard class.
+func taxClassOrDefault(taxClass string) string {
+	if taxClass == "" {
+		return tax.DefaultClass
 	}
+	return taxClass
 }
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+// stubProductRepository keeps the search synonyms it's given. Calling any
+// other method panics.
+type stubProductRepository struct {
+	repositories.ProductRepositoryInterface
+	synonyms []models.SearchSynonym
+}
+
+func (r *stubProductRepository) CreateSearchSynonym(synonym *models.SearchSynonym) error {
+	r.synonyms = append(r.synonyms, *synonym)
+	return nil
+}
+
+func TestCreateSearchSynonymTerms(t *testing.T) {
+	tests := []struct {
+		term    string
+		want    string
+		wantErr bool
+	}{
+		{term: "TV", want: "tv"},
+		{term: " laptop ", want: "laptop"},
+		{term: "smart tv", wantErr: true},
+		{term: "t-shirt", wantErr: true},
+		{term: "  ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term, func(t *testing.T) {
+			productRepo := &stubProductRepository{}
+			service := NewProductService(productRepo)
+
+			_, err := service.CreateSearchSynonym(&dto.CreateSearchSynonymRequest{Term: tt.term, Synonym: "television"})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("term %q was accepted, want an error", tt.term)
+				}
+				if len(productRepo.synonyms) != 0 {
+					t.Errorf("term %q was stored", tt.term)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("CreateSearchSynonym: %v", err)
+			}
+			if len(productRepo.synonyms) != 1 || productRepo.synonyms[0].Term != tt.want {
+				t.Errorf("stored %+v, want term %q", productRepo.synonyms, tt.want)
+			}
+		})
+	}
+}
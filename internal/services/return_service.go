@@ -0,0 +1,276 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/events"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/notifications"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+var _ ReturnServiceInterface = (*ReturnService)(nil)
+
+type ReturnService struct {
+	returnRepo     repositories.ReturnRepositoryInterface
+	paymentService PaymentServiceInterface
+	eventPublisher events.Publisher
+}
+
+func NewReturnService(returnRepo repositories.ReturnRepositoryInterface,
+	paymentService PaymentServiceInterface,
+	eventPublisher events.Publisher) *ReturnService {
+	return &ReturnService{
+		returnRepo:     returnRepo,
+		paymentService: paymentService,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// RequestReturn opens a return for items of one of the user's delivered orders.
+func (s *ReturnService) RequestReturn(userID, orderID uint, req *dto.CreateReturnRequest) (*dto.ReturnResponse, error) {
+	if len(req.Items) == 0 {
+		return nil, errors.New("a return needs at least one item")
+	}
+
+	items := make([]models.ReturnItem, len(req.Items))
+	for i := range req.Items {
+		if req.Items[i].Quantity < 1 {
+			return nil, errors.New("return quantities must be at least 1")
+		}
+		items[i] = models.ReturnItem{
+			OrderItemID: req.Items[i].OrderItemID,
+			Quantity:    req.Items[i].Quantity,
+		}
+	}
+
+	returnRequest, err := s.returnRepo.Create(userID, orderID, req.Reason, items)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishStatusChanged(returnRequest, "")
+
+	response := s.convertToReturnResponse(returnRequest)
+	return &response, nil
+}
+
+func (s *ReturnService) GetReturns(userID uint, page, limit int) ([]dto.ReturnResponse, *utils.PaginationMeta, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	offset := (page - 1) * limit
+
+	total, err := s.returnRepo.GetCountByUserID(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	returns, err := s.returnRepo.GetByUserID(userID, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	meta := &utils.PaginationMeta{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return s.convertToReturnResponses(returns), meta, nil
+}
+
+func (s *ReturnService) GetReturn(userID, returnID uint) (*dto.ReturnResponse, error) {
+	returnRequest, err := s.returnRepo.GetByUserIDAndID(userID, returnID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.convertToReturnResponse(returnRequest)
+	return &response, nil
+}
+
+// GetAllReturns lists the returns of all users, optionally only those in status.
+func (s *ReturnService) GetAllReturns(status string, page, limit int) ([]dto.ReturnResponse, *utils.PaginationMeta, error) {
+	returnStatus := models.ReturnStatus(status)
+	switch returnStatus {
+	case "", models.ReturnStatusRequested, models.ReturnStatusApproved, models.ReturnStatusReceived,
+		models.ReturnStatusRefunded, models.ReturnStatusRejected:
+	default:
+		return nil, nil, fmt.Errorf("invalid return status: %s", status)
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	if limit > 100 {
+		limit = 100
+	}
+
+	offset := (page - 1) * limit
+
+	total, err := s.returnRepo.GetReturnsCount(returnStatus)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	returns, err := s.returnRepo.GetReturns(returnStatus, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	meta := &utils.PaginationMeta{
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return s.convertToReturnResponses(returns), meta, nil
+}
+
+func (s *ReturnService) ApproveReturn(returnID uint) (*dto.ReturnResponse, error) {
+	return s.updateStatus(returnID, models.ReturnStatusApproved, "")
+}
+
+func (s *ReturnService) RejectReturn(returnID uint, req *dto.RejectReturnRequest) (*dto.ReturnResponse, error) {
+	return s.updateStatus(returnID, models.ReturnStatusRejected, req.Reason)
+}
+
+// ReceiveReturn records that the returned items arrived, which puts them back in stock.
+func (s *ReturnService) ReceiveReturn(returnID uint) (*dto.ReturnResponse, error) {
+	return s.updateStatus(returnID, models.ReturnStatusReceived, "")
+}
+
+// RefundReturn gives the money for a received return back through the payment
+// provider and records the refund against the order. The return stays locked
+// while the provider is called, so it can't be refunded twice.
+func (s *ReturnService) RefundReturn(returnID uint, req *dto.RefundReturnRequest) (*dto.ReturnResponse, error) {
+	paid := false
+	returnRequest, previousStatus, err := s.returnRepo.Refund(returnID, func(returnRequest *models.ReturnRequest) (money.Money, error) {
+		amount := returnRequest.RefundableAmount(returnRequest.Order.PricesIncludeTax)
+		if req.Amount != nil {
+			amount = *req.Amount
+		}
+		if !amount.IsPositive() {
+			return amount, errors.New("refund amount must be positive")
+		}
+
+		order := &returnRequest.Order
+		if remaining := order.TotalAmount.Sub(order.RefundedAmount); amount.Cmp(remaining) > 0 {
+			return amount, fmt.Errorf("refund exceeds the %s left to refund on the order", remaining)
+		}
+
+		if err := s.paymentService.Refund(order.ID, amount, order.RefundedAmount.Add(amount)); err != nil {
+			return amount, fmt.Errorf("failed to refund payment: %w", err)
+		}
+		paid = true
+		return amount, nil
+	})
+	if err != nil {
+		if paid {
+			// The provider has already paid out, so this needs reconciling by hand
+			log.Printf("refund for return %d was paid but not recorded: %v", returnID, err)
+		}
+		return nil, err
+	}
+
+	s.publishStatusChanged(returnRequest, previousStatus)
+
+	response := s.convertToReturnResponse(returnRequest)
+	return &response, nil
+}
+
+func (s *ReturnService) updateStatus(returnID uint, status models.ReturnStatus, rejectionReason string) (*dto.ReturnResponse, error) {
+	returnRequest, previousStatus, err := s.returnRepo.UpdateStatus(returnID, status, rejectionReason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishStatusChanged(returnRequest, previousStatus)
+
+	response := s.convertToReturnResponse(returnRequest)
+	return &response, nil
+}
+
+// publishStatusChanged emits the return status event. The change is already
+// committed at this point, so a publish failure is logged rather than
+// returned to the caller.
+func (s *ReturnService) publishStatusChanged(returnRequest *models.ReturnRequest, previousStatus models.ReturnStatus) {
+	event := dto.ReturnStatusChangedEvent{
+		ReturnID:       returnRequest.ID,
+		OrderID:        returnRequest.OrderID,
+		UserID:         returnRequest.UserID,
+		PreviousStatus: string(previousStatus),
+		Status:         string(returnRequest.Status),
+		RefundAmount:   returnRequest.RefundAmount,
+		ChangedAt:      returnRequest.UpdatedAt,
+	}
+
+	if err := s.eventPublisher.Publish(notifications.ReturnStatusChanged, event, map[string]string{}); err != nil {
+		log.Printf("unable to publish return status event for return %d: %v", returnRequest.ID, err)
+	}
+}
+
+func (s *ReturnService) convertToReturnResponses(returns []models.ReturnRequest) []dto.ReturnResponse {
+	response := make([]dto.ReturnResponse, len(returns))
+	for i := range returns {
+		response[i] = s.convertToReturnResponse(&returns[i])
+	}
+	return response
+}
+
+func (s *ReturnService) convertToReturnResponse(returnRequest *models.ReturnRequest) dto.ReturnResponse {
+	items := make([]dto.ReturnItemResponse, len(returnRequest.Items))
+	for i := range returnRequest.Items {
+		item := returnRequest.Items[i]
+
+		items[i] = dto.ReturnItemResponse{
+			ID:          item.ID,
+			OrderItemID: item.OrderItemID,
+			ProductID:   item.OrderItem.ProductID,
+			ProductName: item.OrderItem.DisplayName(),
+			SKU:         item.OrderItem.ProductSKU,
+			Quantity:    item.Quantity,
+		}
+	}
+
+	return dto.ReturnResponse{
+		ID:              returnRequest.ID,
+		OrderID:         returnRequest.OrderID,
+		UserID:          returnRequest.UserID,
+		Status:          string(returnRequest.Status),
+		Reason:          returnRequest.Reason,
+		RejectionReason: returnRequest.RejectionReason,
+		RefundAmount:    returnRequest.RefundAmount,
+		Items:           items,
+		ApprovedAt:      returnRequest.ApprovedAt,
+		ReceivedAt:      returnRequest.ReceivedAt,
+		RefundedAt:      returnRequest.RefundedAt,
+		RejectedAt:      returnRequest.RejectedAt,
+		CreatedAt:       returnRequest.CreatedAt,
+		UpdatedAt:       returnRequest.UpdatedAt,
+	}
+}
@@ -0,0 +1,313 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+var _ ShippingServiceInterface = (*ShippingService)(nil)
+
+var errShippingUnavailable = errors.New("no shipping method is available for this address")
+
+type ShippingService struct {
+	shippingRepo repositories.ShippingRepositoryInterface
+	cartRepo     repositories.CartRepositoryInterface
+	addressRepo  repositories.AddressRepositoryInterface
+}
+
+func NewShippingService(shippingRepo repositories.ShippingRepositoryInterface,
+	cartRepo repositories.CartRepositoryInterface,
+	addressRepo repositories.AddressRepositoryInterface) *ShippingService {
+	return &ShippingService{
+		shippingRepo: shippingRepo,
+		cartRepo:     cartRepo,
+		addressRepo:  addressRepo,
+	}
+}
+
+// shippingQuote is the cost of shipping the cart with a method.
+type shippingQuote struct {
+	method *models.ShippingMethod
+	zone   *models.ShippingZone
+	cost   money.Money
+}
+
+// GetShippingOptions quotes the active methods that ship the user's cart to
+// the requested destination, cheapest first.
+func (s *ShippingService) GetShippingOptions(userID uint, req *dto.ShippingOptionsRequest) ([]dto.ShippingOptionResponse, error) {
+	country, region, err := s.resolveDestination(userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.cartRepo.GetByUserID(userID)
+	if err != nil || len(cart.CartItems) == 0 {
+		return nil, errors.New("cart is empty")
+	}
+
+	return s.shippingOptions(cart, country, region)
+}
+
+// GetGuestShippingOptions quotes the active methods that ship a guest cart to
+// the requested country, cheapest first.
+func (s *ShippingService) GetGuestShippingOptions(cartID uint, req *dto.GuestShippingOptionsRequest) ([]dto.ShippingOptionResponse, error) {
+	cart, err := s.cartRepo.GetGuestCart(cartID)
+	if err != nil || len(cart.CartItems) == 0 {
+		return nil, errors.New("cart is empty")
+	}
+
+	return s.shippingOptions(cart, strings.ToUpper(req.Country), req.Region)
+}
+
+func (s *ShippingService) shippingOptions(cart *models.Cart, country, region string) ([]dto.ShippingOptionResponse, error) {
+	quotes, err := s.quote(cart, country, region)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.ShippingOptionResponse, len(quotes))
+	for i := range quotes {
+		response[i] = dto.ShippingOptionResponse{
+			MethodID: quotes[i].method.ID,
+			Name:     quotes[i].method.Name,
+			Type:     string(quotes[i].method.Type),
+			ZoneName: quotes[i].zone.Name,
+			Cost:     quotes[i].cost,
+		}
+	}
+
+	return response, nil
+}
+
+// ChooseMethod returns the shipping method used to ship the user's cart to
+// address. The chosen method must ship there; without a choice the cheapest
+// method is used.
+func (s *ShippingService) ChooseMethod(userID uint, address *models.Address, methodID *uint) (*models.ShippingMethod, error) {
+	return s.chooseMethod(address, methodID, func() (*models.Cart, error) {
+		return s.cartRepo.GetByUserID(userID)
+	})
+}
+
+// ChooseGuestMethod is ChooseMethod for a guest cart.
+func (s *ShippingService) ChooseGuestMethod(cartID uint, address *models.Address, methodID *uint) (*models.ShippingMethod, error) {
+	return s.chooseMethod(address, methodID, func() (*models.Cart, error) {
+		return s.cartRepo.GetGuestCart(cartID)
+	})
+}
+
+// chooseMethod picks the method for ChooseMethod. The cart is only loaded
+// when the cheapest method has to be worked out.
+func (s *ShippingService) chooseMethod(address *models.Address, methodID *uint, loadCart func() (*models.Cart, error)) (*models.ShippingMethod, error) {
+	if methodID != nil {
+		zone, err := s.matchZone(address.Country, address.Region)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range zone.Methods {
+			if zone.Methods[i].ID == *methodID && zone.Methods[i].IsActive {
+				return &zone.Methods[i], nil
+			}
+		}
+		return nil, errors.New("shipping method is not available for this address")
+	}
+
+	cart, err := loadCart()
+	if err != nil || len(cart.CartItems) == 0 {
+		return nil, errors.New("cart is empty")
+	}
+
+	quotes, err := s.quote(cart, address.Country, address.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return quotes[0].method, nil
+}
+
+// quote prices each active method of the zone covering the destination for the
+// cart, cheapest first. Methods are quoted on the cart after its coupon
+// discount, and a free shipping coupon makes every method free.
+func (s *ShippingService) quote(cart *models.Cart, country, region string) ([]shippingQuote, error) {
+	zone, err := s.matchZone(country, region)
+	if err != nil {
+		return nil, err
+	}
+
+	orderValue := money.New(0)
+	for i := range cart.CartItems {
+		orderValue = orderValue.Add(cart.CartItems[i].UnitPrice().Mul(cart.CartItems[i].Quantity))
+	}
+
+	freeShipping := false
+	if cart.Coupon != nil && cart.Coupon.CheckAvailable(time.Now()) == nil {
+		if discount, err := cart.Coupon.Discount(cart.CartItems); err == nil {
+			orderValue = orderValue.Sub(discount)
+			freeShipping = cart.Coupon.Type == models.CouponTypeFreeShipping
+		}
+	}
+
+	weight := models.ShippingWeight(cart.CartItems)
+
+	var quotes []shippingQuote
+	for i := range zone.Methods {
+		method := &zone.Methods[i]
+		if !method.IsActive {
+			continue
+		}
+
+		cost := method.Quote(orderValue, weight)
+		if freeShipping {
+			cost = money.New(0)
+		}
+		quotes = append(quotes, shippingQuote{method: method, zone: zone, cost: cost})
+	}
+
+	if len(quotes) == 0 {
+		return nil, errShippingUnavailable
+	}
+
+	slices.SortStableFunc(quotes, func(a, b shippingQuote) int {
+		return a.cost.Cmp(b.cost)
+	})
+	return quotes, nil
+}
+
+// matchZone returns the zone that covers the destination most closely.
+func (s *ShippingService) matchZone(country, region string) (*models.ShippingZone, error) {
+	zones, err := s.shippingRepo.GetZones()
+	if err != nil {
+		return nil, err
+	}
+
+	zone := models.MatchShippingZone(zones, country, region)
+	if zone == nil {
+		return nil, errShippingUnavailable
+	}
+	return zone, nil
+}
+
+// resolveDestination works out the country and region to quote shipping for.
+func (s *ShippingService) resolveDestination(userID uint, req *dto.ShippingOptionsRequest) (string, string, error) {
+	if req.AddressID != nil {
+		address, err := s.addressRepo.GetByUserIDAndID(userID, *req.AddressID)
+		if err != nil {
+			return "", "", errors.New("address not found")
+		}
+		return address.Country, address.Region, nil
+	}
+
+	if req.Country != "" {
+		return strings.ToUpper(req.Country), req.Region, nil
+	}
+
+	address, err := s.addressRepo.GetDefaultShipping(userID)
+	if err != nil {
+		return "", "", errors.New("shipping address not found")
+	}
+	return address.Country, address.Region, nil
+}
+
+func (s *ShippingService) GetZones() ([]dto.ShippingZoneResponse, error) {
+	zones, err := s.shippingRepo.GetZones()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.ShippingZoneResponse, len(zones))
+	for i := range zones {
+		response[i] = s.convertToShippingZoneResponse(&zones[i])
+	}
+
+	return response, nil
+}
+
+func (s *ShippingService) CreateZone(req *dto.CreateShippingZoneRequest) (*dto.ShippingZoneResponse, error) {
+	zone := models.ShippingZone{Name: req.Name}
+	for _, location := range req.Locations {
+		zone.Locations = append(zone.Locations, models.ShippingZoneLocation{
+			Country: strings.ToUpper(location.Country),
+			Region:  location.Region,
+		})
+	}
+
+	if err := s.shippingRepo.CreateZone(&zone); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToShippingZoneResponse(&zone)
+	return &response, nil
+}
+
+func (s *ShippingService) CreateMethod(zoneID uint, req *dto.CreateShippingMethodRequest) (*dto.ShippingMethodResponse, error) {
+	if _, err := s.shippingRepo.GetZoneByID(zoneID); err != nil {
+		return nil, errors.New("shipping zone not found")
+	}
+
+	method := models.ShippingMethod{
+		ZoneID:        zoneID,
+		Name:          req.Name,
+		Type:          models.ShippingMethodType(req.Type),
+		Rate:          req.Rate,
+		PerKgRate:     req.PerKgRate,
+		FreeThreshold: req.FreeThreshold,
+		IsActive:      true,
+	}
+
+	if method.Rate.IsNegative() || method.PerKgRate.IsNegative() || method.FreeThreshold.IsNegative() {
+		return nil, errors.New("shipping rates cannot be negative")
+	}
+
+	if err := s.shippingRepo.CreateMethod(&method); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToShippingMethodResponse(&method)
+	return &response, nil
+}
+
+func (s *ShippingService) convertToShippingZoneResponse(zone *models.ShippingZone) dto.ShippingZoneResponse {
+	locations := make([]dto.ShippingZoneLocationResponse, len(zone.Locations))
+	for i := range zone.Locations {
+		locations[i] = dto.ShippingZoneLocationResponse{
+			Country: zone.Locations[i].Country,
+			Region:  zone.Locations[i].Region,
+		}
+	}
+
+	methods := make([]dto.ShippingMethodResponse, len(zone.Methods))
+	for i := range zone.Methods {
+		methods[i] = s.convertToShippingMethodResponse(&zone.Methods[i])
+	}
+
+	return dto.ShippingZoneResponse{
+		ID:        zone.ID,
+		Name:      zone.Name,
+		Locations: locations,
+		Methods:   methods,
+		CreatedAt: zone.CreatedAt,
+		UpdatedAt: zone.UpdatedAt,
+	}
+}
+
+func (s *ShippingService) convertToShippingMethodResponse(method *models.ShippingMethod) dto.ShippingMethodResponse {
+	return dto.ShippingMethodResponse{
+		ID:            method.ID,
+		ZoneID:        method.ZoneID,
+		Name:          method.Name,
+		Type:          string(method.Type),
+		Rate:          method.Rate,
+		PerKgRate:     method.PerKgRate,
+		FreeThreshold: method.FreeThreshold,
+		IsActive:      method.IsActive,
+		CreatedAt:     method.CreatedAt,
+		UpdatedAt:     method.UpdatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+)
+
+// StockReservationSweeper releases expired stock reservations in the
+// background. Expired reservations already stop holding stock, so sweeping
+// only keeps them from piling up.
+type StockReservationSweeper struct {
+	reservationRepo repositories.StockReservationRepositoryInterface
+	interval        time.Duration
+}
+
+func NewStockReservationSweeper(reservationRepo repositories.StockReservationRepositoryInterface,
+	interval time.Duration) *StockReservationSweeper {
+	return &StockReservationSweeper{
+		reservationRepo: reservationRepo,
+		interval:        interval,
+	}
+}
+
+// Run sweeps every interval until ctx is cancelled. A zero interval disables sweeping.
+func (s *StockReservationSweeper) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Sweep()
+		}
+	}
+}
+
+// Sweep deletes the reservations that have expired.
+func (s *StockReservationSweeper) Sweep() {
+	released, err := s.reservationRepo.DeleteExpired(time.Now())
+	if err != nil {
+		log.Printf("unable to release expired stock reservations: %v", err)
+		return
+	}
+
+	if released > 0 {
+		log.Printf("released %d expired stock reservations", released)
+	}
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/tax"
+)
+
+var _ TaxServiceInterface = (*TaxService)(nil)
+
+type TaxService struct {
+	taxRateRepo      repositories.TaxRateRepositoryInterface
+	pricesIncludeTax bool
+}
+
+// NewTaxService creates the tax service. pricesIncludeTax says whether catalog
+// prices already contain tax.
+func NewTaxService(taxRateRepo repositories.TaxRateRepositoryInterface, pricesIncludeTax bool) *TaxService {
+	return &TaxService{
+		taxRateRepo:      taxRateRepo,
+		pricesIncludeTax: pricesIncludeTax,
+	}
+}
+
+// CalculatorFor returns a calculator loaded with the rates of a country. A
+// country without rates, or an empty one, is not taxed.
+func (s *TaxService) CalculatorFor(country string) (*tax.Calculator, error) {
+	if country == "" {
+		return tax.NewCalculator(nil, s.pricesIncludeTax), nil
+	}
+
+	rates, err := s.taxRateRepo.GetByCountry(strings.ToUpper(country))
+	if err != nil {
+		return nil, err
+	}
+
+	taxRates := make([]tax.Rate, len(rates))
+	for i := range rates {
+		taxRates[i] = tax.Rate{
+			Country:     rates[i].Country,
+			Region:      rates[i].Region,
+			TaxClass:    rates[i].TaxClass,
+			Name:        rates[i].Name,
+			BasisPoints: rates[i].BasisPoints,
+		}
+	}
+
+	return tax.NewCalculator(taxRates, s.pricesIncludeTax), nil
+}
+
+func (s *TaxService) GetTaxRates() ([]dto.TaxRateResponse, error) {
+	rates, err := s.taxRateRepo.GetTaxRates()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.TaxRateResponse, len(rates))
+	for i := range rates {
+		response[i] = s.convertToTaxRateResponse(&rates[i])
+	}
+
+	return response, nil
+}
+
+func (s *TaxService) CreateTaxRate(req *dto.CreateTaxRateRequest) (*dto.TaxRateResponse, error) {
+	rate := models.TaxRate{
+		Country:     strings.ToUpper(req.Country),
+		Region:      req.Region,
+		TaxClass:    req.TaxClass,
+		Name:        req.Name,
+		BasisPoints: req.BasisPoints,
+	}
+
+	if err := s.taxRateRepo.Create(&rate); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToTaxRateResponse(&rate)
+	return &response, nil
+}
+
+func (s *TaxService) DeleteTaxRate(id uint) error {
+	return s.taxRateRepo.Delete(id)
+}
+
+func (s *TaxService) convertToTaxRateResponse(rate *models.TaxRate) dto.TaxRateResponse {
+	return dto.TaxRateResponse{
+		ID:          rate.ID,
+		Country:     rate.Country,
+		Region:      rate.Region,
+		TaxClass:    rate.TaxClass,
+		Name:        rate.Name,
+		BasisPoints: rate.BasisPoints,
+		CreatedAt:   rate.CreatedAt,
+		UpdatedAt:   rate.UpdatedAt,
+	}
+}
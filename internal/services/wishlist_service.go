@@ -0,0 +1,245 @@
+package services
+
+import (
+	"errors"
+	"log"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/dto"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/models"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/repositories"
+	"github.com/vijayaragavanmg/learning-go-shop/internal/utils"
+)
+
+var _ WishlistServiceInterface = (*WishlistService)(nil)
+
+var errWishlistNotFound = errors.New("wishlist not found")
+
+type WishlistService struct {
+	wishlistRepo repositories.WishlistRepositoryInterface
+	productRepo  repositories.ProductRepositoryInterface
+	cartService  CartServiceInterface
+}
+
+func NewWishlistService(wishlistRepo repositories.WishlistRepositoryInterface,
+	productRepo repositories.ProductRepositoryInterface,
+	cartService CartServiceInterface) *WishlistService {
+	return &WishlistService{
+		wishlistRepo: wishlistRepo,
+		productRepo:  productRepo,
+		cartService:  cartService,
+	}
+}
+
+func (s *WishlistService) GetWishlists(userID uint) ([]dto.WishlistResponse, error) {
+	wishlists, err := s.wishlistRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.WishlistResponse, len(wishlists))
+	for i := range wishlists {
+		response[i] = s.convertToWishlistResponse(&wishlists[i])
+	}
+
+	return response, nil
+}
+
+func (s *WishlistService) GetWishlist(userID, wishlistID uint) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.GetByUserIDAndID(userID, wishlistID)
+	if err != nil {
+		return nil, errWishlistNotFound
+	}
+
+	response := s.convertToWishlistResponse(wishlist)
+	return &response, nil
+}
+
+// GetSharedWishlist returns the wishlist shared under token, for anyone who
+// has the link.
+func (s *WishlistService) GetSharedWishlist(token string) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.GetByShareToken(token)
+	if err != nil {
+		return nil, errWishlistNotFound
+	}
+
+	response := s.convertToWishlistResponse(wishlist)
+	return &response, nil
+}
+
+func (s *WishlistService) CreateWishlist(userID uint, req *dto.CreateWishlistRequest) (*dto.WishlistResponse, error) {
+	wishlist := models.Wishlist{
+		UserID: userID,
+		Name:   req.Name,
+	}
+
+	if err := s.wishlistRepo.Create(&wishlist); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToWishlistResponse(&wishlist)
+	return &response, nil
+}
+
+func (s *WishlistService) UpdateWishlist(userID, wishlistID uint, req *dto.UpdateWishlistRequest) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.GetByUserIDAndID(userID, wishlistID)
+	if err != nil {
+		return nil, errWishlistNotFound
+	}
+
+	wishlist.Name = req.Name
+	if err := s.wishlistRepo.Update(wishlist); err != nil {
+		return nil, err
+	}
+
+	response := s.convertToWishlistResponse(wishlist)
+	return &response, nil
+}
+
+func (s *WishlistService) DeleteWishlist(userID, wishlistID uint) error {
+	if _, err := s.wishlistRepo.GetByUserIDAndID(userID, wishlistID); err != nil {
+		return errWishlistNotFound
+	}
+
+	return s.wishlistRepo.Delete(userID, wishlistID)
+}
+
+// AddItem saves a product to one of the user's wishlists. Saving a product
+// that is already on the list leaves the list as it is.
+func (s *WishlistService) AddItem(userID, wishlistID uint, req *dto.AddWishlistItemRequest) (*dto.WishlistResponse, error) {
+	if _, err := s.wishlistRepo.GetByUserIDAndID(userID, wishlistID); err != nil {
+		return nil, errWishlistNotFound
+	}
+
+	product, err := s.productRepo.GetProductByID(req.ProductID)
+	if err != nil || !product.IsActive {
+		return nil, errors.New("product not found")
+	}
+
+	item := models.WishlistItem{
+		WishlistID: wishlistID,
+		ProductID:  product.ID,
+	}
+	if err := s.wishlistRepo.AddItem(&item); err != nil {
+		return nil, err
+	}
+
+	return s.GetWishlist(userID, wishlistID)
+}
+
+func (s *WishlistService) RemoveItem(userID, wishlistID, itemID uint) (*dto.WishlistResponse, error) {
+	if _, err := s.getItem(userID, wishlistID, itemID); err != nil {
+		return nil, err
+	}
+
+	if err := s.wishlistRepo.RemoveItem(wishlistID, itemID); err != nil {
+		return nil, err
+	}
+
+	return s.GetWishlist(userID, wishlistID)
+}
+
+// MoveToCart adds a wishlist item's product to the user's cart, with the
+// cart's usual stock checks, and takes it off the wishlist.
+func (s *WishlistService) MoveToCart(userID, wishlistID, itemID uint, req *dto.MoveWishlistItemRequest) (*dto.CartResponse, error) {
+	item, err := s.getItem(userID, wishlistID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := req.Quantity
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	cart, err := s.cartService.AddToCart(userID, &dto.AddToCartRequest{
+		ProductID: item.ProductID,
+		VariantID: req.VariantID,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The product is in the cart by now, so a failure here only leaves it on the list as well
+	if err := s.wishlistRepo.RemoveItem(wishlistID, itemID); err != nil {
+		log.Printf("unable to remove item %d from wishlist %d after moving it to the cart: %v", itemID, wishlistID, err)
+	}
+
+	return cart, nil
+}
+
+// ShareWishlist turns on sharing for a wishlist. A list that is already
+// shared keeps its link.
+func (s *WishlistService) ShareWishlist(userID, wishlistID uint) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.GetByUserIDAndID(userID, wishlistID)
+	if err != nil {
+		return nil, errWishlistNotFound
+	}
+
+	if wishlist.ShareToken == nil {
+		token, _, err := utils.GenerateAccessToken()
+		if err != nil {
+			return nil, err
+		}
+
+		wishlist.ShareToken = &token
+		if err := s.wishlistRepo.Update(wishlist); err != nil {
+			return nil, err
+		}
+	}
+
+	response := s.convertToWishlistResponse(wishlist)
+	return &response, nil
+}
+
+// UnshareWishlist turns off sharing for a wishlist, so its old link stops
+// working. Sharing it again gives it a new link.
+func (s *WishlistService) UnshareWishlist(userID, wishlistID uint) (*dto.WishlistResponse, error) {
+	wishlist, err := s.wishlistRepo.GetByUserIDAndID(userID, wishlistID)
+	if err != nil {
+		return nil, errWishlistNotFound
+	}
+
+	if wishlist.ShareToken != nil {
+		wishlist.ShareToken = nil
+		if err := s.wishlistRepo.Update(wishlist); err != nil {
+			return nil, err
+		}
+	}
+
+	response := s.convertToWishlistResponse(wishlist)
+	return &response, nil
+}
+
+// getItem returns an item of one of the user's wishlists.
+func (s *WishlistService) getItem(userID, wishlistID, itemID uint) (*models.WishlistItem, error) {
+	if _, err := s.wishlistRepo.GetByUserIDAndID(userID, wishlistID); err != nil {
+		return nil, errWishlistNotFound
+	}
+
+	item, err := s.wishlistRepo.GetItem(wishlistID, itemID)
+	if err != nil {
+		return nil, errors.New("wishlist item not found")
+	}
+	return item, nil
+}
+
+func (s *WishlistService) convertToWishlistResponse(wishlist *models.Wishlist) dto.WishlistResponse {
+	items := make([]dto.WishlistItemResponse, len(wishlist.Items))
+	for i := range wishlist.Items {
+		items[i] = dto.WishlistItemResponse{
+			ID:        wishlist.Items[i].ID,
+			Product:   convertToProductResponse(&wishlist.Items[i].Product),
+			CreatedAt: wishlist.Items[i].CreatedAt,
+		}
+	}
+
+	return dto.WishlistResponse{
+		ID:         wishlist.ID,
+		Name:       wishlist.Name,
+		ShareToken: wishlist.ShareToken,
+		Items:      items,
+		CreatedAt:  wishlist.CreatedAt,
+		UpdatedAt:  wishlist.UpdatedAt,
+	}
+}
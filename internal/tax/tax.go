@@ -0,0 +1,133 @@
+// Package tax calculates sales tax for order lines from jurisdiction rates.
+package tax
+
+import (
+	"strings"
+
+	"github.com/vijayaragavanmg/learning-go-shop/internal/money"
+)
+
+// DefaultClass is the tax class used for products that don't name one.
+const DefaultClass = "standard"
+
+// ShippingClass is the tax class of shipping charges. Shipping is only taxed
+// where a rate is configured for this class.
+const ShippingClass = "shipping"
+
+// basisPointsPerUnit is the number of basis points in a rate of 100%.
+const basisPointsPerUnit = 10000
+
+// Rate is the tax rate for one tax class in a country, or in a region of it.
+// A rate with an empty Region applies to the whole country.
+type Rate struct {
+	Country  string
+	Region   string
+	TaxClass string
+	Name     string
+
+	// BasisPoints is the rate in hundredths of a percent, so 2000 is 20%.
+	BasisPoints int
+}
+
+// Address is the jurisdiction the tax is calculated for.
+type Address struct {
+	Country string
+	Region  string
+}
+
+// Line is an amount to be taxed. Discount is taken off Amount before tax.
+type Line struct {
+	Amount   money.Money
+	Discount money.Money
+	TaxClass string
+}
+
+// LineResult is the tax on a single line.
+type LineResult struct {
+	Tax         money.Money
+	BasisPoints int
+}
+
+// Result is the tax on a set of lines. Total is the amount payable: the
+// discounted amount, plus the tax when prices exclude it.
+type Result struct {
+	Lines    []LineResult
+	Subtotal money.Money
+	Discount money.Money
+	Tax      money.Money
+	Total    money.Money
+}
+
+// Calculator applies a set of rates to order lines. With PricesIncludeTax the
+// line amounts already contain the tax and it is only extracted from them.
+type Calculator struct {
+	rates            []Rate
+	pricesIncludeTax bool
+}
+
+func NewCalculator(rates []Rate, pricesIncludeTax bool) *Calculator {
+	return &Calculator{rates: rates, pricesIncludeTax: pricesIncludeTax}
+}
+
+// PricesIncludeTax reports whether line amounts are tax inclusive.
+func (c *Calculator) PricesIncludeTax() bool {
+	return c.pricesIncludeTax
+}
+
+// Calculate works out the tax on each line for the given address. Tax is
+// rounded per line, half away from zero.
+func (c *Calculator) Calculate(address Address, lines []Line) Result {
+	result := Result{
+		Lines:    make([]LineResult, len(lines)),
+		Subtotal: money.New(0),
+		Discount: money.New(0),
+		Tax:      money.New(0),
+	}
+
+	for i := range lines {
+		basisPoints := c.RateFor(address, lines[i].TaxClass)
+		taxable := lines[i].Amount.Sub(lines[i].Discount)
+
+		var lineTax money.Money
+		if c.pricesIncludeTax {
+			lineTax = taxable.Mul(basisPoints).Div(basisPointsPerUnit + basisPoints)
+		} else {
+			lineTax = taxable.Mul(basisPoints).Div(basisPointsPerUnit)
+		}
+
+		result.Lines[i] = LineResult{Tax: lineTax, BasisPoints: basisPoints}
+		result.Subtotal = result.Subtotal.Add(lines[i].Amount)
+		result.Discount = result.Discount.Add(lines[i].Discount)
+		result.Tax = result.Tax.Add(lineTax)
+	}
+
+	result.Total = result.Subtotal.Sub(result.Discount)
+	if !c.pricesIncludeTax {
+		result.Total = result.Total.Add(result.Tax)
+	}
+	return result
+}
+
+// RateFor returns the rate in basis points for a tax class at the address. A
+// rate for the address's region wins over the country-wide rate, and an
+// address without a matching rate is not taxed.
+func (c *Calculator) RateFor(address Address, taxClass string) int {
+	if taxClass == "" {
+		taxClass = DefaultClass
+	}
+
+	basisPoints, found := 0, false
+	for _, rate := range c.rates {
+		if !strings.EqualFold(rate.Country, address.Country) || !strings.EqualFold(rate.TaxClass, taxClass) {
+			continue
+		}
+
+		switch {
+		case rate.Region != "" && strings.EqualFold(rate.Region, address.Region):
+			return rate.BasisPoints
+		case rate.Region == "" && !found:
+			basisPoints, found = rate.BasisPoints, true
+		}
+	}
+	return basisPoints
+}
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// GenerateAccessToken generates a random opaque token and the hash to store for it
+func GenerateAccessToken() (token, hash string, err error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", "", err
+	}
+
+	token = hex.EncodeToString(bytes)
+	return token, HashAccessToken(token), nil
+}
+
+// HashAccessToken hashes an access token for storage and lookup
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
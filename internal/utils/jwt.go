@@ -79,3 +79,50 @@ func ValidateToken(tokenString, secret string) (*Claims, error) {
 	return nil, errors.New("invalid token")
 
 }
+
+// CartClaims identifies a guest cart
+type CartClaims struct {
+	CartID uint `json:"cart_id"`
+	jwt.RegisteredClaims
+}
+
+// cartTokenKey derives the key guest cart tokens are signed with, so a cart
+// token can never pass as a user token or the other way round
+func cartTokenKey(secret string) []byte {
+	return []byte("cart:" + secret)
+}
+
+// GenerateCartToken generates the token that identifies a guest cart
+func GenerateCartToken(cfg *config.JWTConfig, cartID uint) (string, error) {
+	claims := &CartClaims{
+		CartID: cartID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(cfg.CartTokenExpires)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(cartTokenKey(cfg.Secret))
+}
+
+// ValidateCartToken checks if a guest cart token is valid and returns the cart ID
+func ValidateCartToken(tokenString, secret string) (uint, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &CartClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return cartTokenKey(secret), nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	if claims, ok := token.Claims.(*CartClaims); ok && token.Valid && claims.CartID != 0 {
+		return claims.CartID, nil
+	}
+
+	return 0, errors.New("invalid cart token")
+}
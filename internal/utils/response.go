@@ -18,6 +18,12 @@ type PaginatedResponse struct {
 	Meta PaginationMeta `json:"meta"`
 }
 
+// SearchResponse is a page of search results with the facets of the search.
+type SearchResponse struct {
+	PaginatedResponse
+	Facets interface{} `json:"facets"`
+}
+
 type PaginationMeta struct {
 	Page       int   `json:"page"`
 	Limit      int   `json:"limit"`
@@ -70,6 +76,10 @@ func NotFoundResponse(c *gin.Context, message string) {
 	ErrorResponse(c, http.StatusNotFound, message, nil)
 }
 
+func ConflictResponse(c *gin.Context, message string, err error) {
+	ErrorResponse(c, http.StatusConflict, message, err)
+}
+
 func InternalServerErrorResponse(c *gin.Context, message string, err error) {
 	ErrorResponse(c, http.StatusInternalServerError, message, err)
 }
@@ -84,3 +94,17 @@ func PaginatedSuccessResponse(c *gin.Context, message string, data interface{},
 		Meta: meta,
 	})
 }
+
+func SearchSuccessResponse(c *gin.Context, message string, data interface{}, meta PaginationMeta, facets interface{}) {
+	c.JSON(http.StatusOK, SearchResponse{
+		PaginatedResponse: PaginatedResponse{
+			Response: Response{
+				Success: true,
+				Message: message,
+				Data:    data,
+			},
+			Meta: meta,
+		},
+		Facets: facets,
+	})
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	slugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+	slugPattern    = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+// Slugify turns a name into a URL slug of lowercase letters, digits and
+// single hyphens, e.g. "Men's Shoes" becomes "men-s-shoes".
+func Slugify(name string) string {
+	return strings.Trim(slugSeparators.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// IsSlug reports whether s is a valid URL slug.
+func IsSlug(s string) bool {
+	return slugPattern.MatchString(s)
+}